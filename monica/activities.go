@@ -0,0 +1,432 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// ActivitiesService handles communication with the activity related methods of the API.
+// API docs: https://www.monicahq.com/api/activities
+type ActivitiesService service
+
+// ActivityTypesService handles communication with the activity type related methods of the API.
+// API docs: https://www.monicahq.com/api/activitytypes
+type ActivityTypesService service
+
+// ActivityTypeCategoriesService handles communication with the activity type
+// category related methods of the API.
+// API docs: https://www.monicahq.com/api/activitytypecategories
+type ActivityTypeCategoriesService service
+
+type Activity struct {
+	Id          int    `json:"id,omitempty"`
+	Object      string `json:"object,omitempty"`
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	HappenedAt  Date   `json:"happened_at"`
+	// ActivityType is nil for activities without a type
+	ActivityType *ActivityType `json:"activity_type"`
+	Attendees    struct {
+		Total    int        `json:"total"`
+		Contacts []*Contact `json:"contacts"`
+	} `json:"attendees"`
+	Emotions []*Emotion `json:"emotions"`
+	Account  struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type ActivityInput struct {
+	ActivityTypeId int `json:"activity_type_id,omitempty"`
+	// Summary of the activity, max 255 characters
+	Summary string `json:"summary"`
+	// Description of the activity, max 1000000 characters
+	Description string `json:"description,omitempty"`
+	HappenedAt  Date   `json:"happened_at"`
+	// Contacts are the ids of all attending contacts, at least one is required
+	Contacts []int `json:"contacts"`
+	// Emotions are the ids of the emotions felt during the activity
+	Emotions []int `json:"emotions,omitempty"`
+}
+
+type ActivityType struct {
+	Id           int    `json:"id,omitempty"`
+	Object       string `json:"object,omitempty"`
+	Name         string `json:"name"`
+	LocationType string `json:"location_type,omitempty"`
+	// ActivityTypeCategory is the category the type belongs to
+	ActivityTypeCategory *ActivityTypeCategory `json:"activity_type_category"`
+	Account              struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type ActivityTypeInput struct {
+	// Name of the activity type, max 255 characters
+	Name                   string `json:"name"`
+	ActivityTypeCategoryId int    `json:"activity_type_category_id"`
+}
+
+type ActivityTypeCategory struct {
+	Id      int    `json:"id,omitempty"`
+	Object  string `json:"object,omitempty"`
+	Name    string `json:"name"`
+	Account struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type ActivityTypeCategoryInput struct {
+	// Name of the category, max 255 characters
+	Name string `json:"name"`
+}
+
+type ActivityListOptions struct {
+	ListOptions
+}
+
+type ActivityTypeListOptions struct {
+	ListOptions
+}
+
+type ActivityTypeCategoryListOptions struct {
+	ListOptions
+}
+
+type listActivitiesResponse struct {
+	Data *[]*Activity `json:"data"`
+	Meta ListMeta     `json:"meta"`
+}
+
+type listActivityTypesResponse struct {
+	Data *[]*ActivityType `json:"data"`
+	Meta ListMeta         `json:"meta"`
+}
+
+type listActivityTypeCategoriesResponse struct {
+	Data *[]*ActivityTypeCategory `json:"data"`
+	Meta ListMeta                 `json:"meta"`
+}
+
+// ListActivities Lists the activities of all contacts
+func (s *ActivitiesService) ListActivities(ctx context.Context, opts *ActivityListOptions) (*[]*Activity, *ListMeta, error) {
+	url, err := addOptions("activities", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listActivitiesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListActivitiesForContact Lists the activities a single contact attended
+func (s *ActivitiesService) ListActivitiesForContact(ctx context.Context, contactId int, opts *ActivityListOptions) (*[]*Activity, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/activities", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listActivitiesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetActivity Retrieves a single activity
+func (s *ActivitiesService) GetActivity(ctx context.Context, id int) (*Activity, error) {
+	url := fmt.Sprintf("activities/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Activity `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateActivity Logs an activity with one or more attending contacts
+func (s *ActivitiesService) CreateActivity(ctx context.Context, input *ActivityInput) (*Activity, error) {
+	req, err := s.client.NewRequest("POST", "activities", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Activity `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateActivity Updates an activity, replacing its attendees
+func (s *ActivitiesService) UpdateActivity(ctx context.Context, id int, input *ActivityInput) (*Activity, error) {
+	url := fmt.Sprintf("activities/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Activity `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteActivity Deletes an activity
+func (s *ActivitiesService) DeleteActivity(ctx context.Context, id int) error {
+	url := fmt.Sprintf("activities/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllActivities walks all pages of activities starting at opts.Page and
+// calls fn for every activity. Iteration stops at the first error returned by
+// fn.
+func (s *ActivitiesService) ListAllActivities(ctx context.Context, opts *ActivityListOptions, fn func(*Activity) error) error {
+	o := ActivityListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var activities *[]*Activity
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		activities, meta, err = s.ListActivities(ctx, &o)
+		return meta, err
+	}, func() error {
+		if activities == nil {
+			return nil
+		}
+		for _, activity := range *activities {
+			if err := fn(activity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListActivityTypes Lists all activity types
+func (s *ActivityTypesService) ListActivityTypes(ctx context.Context, opts *ActivityTypeListOptions) (*[]*ActivityType, *ListMeta, error) {
+	url, err := addOptions("activitytypes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listActivityTypesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetActivityType Retrieves a single activity type
+func (s *ActivityTypesService) GetActivityType(ctx context.Context, id int) (*ActivityType, error) {
+	url := fmt.Sprintf("activitytypes/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ActivityType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateActivityType Creates an activity type
+func (s *ActivityTypesService) CreateActivityType(ctx context.Context, input *ActivityTypeInput) (*ActivityType, error) {
+	req, err := s.client.NewRequest("POST", "activitytypes", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ActivityType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateActivityType Updates an activity type
+func (s *ActivityTypesService) UpdateActivityType(ctx context.Context, id int, input *ActivityTypeInput) (*ActivityType, error) {
+	url := fmt.Sprintf("activitytypes/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ActivityType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteActivityType Deletes an activity type
+func (s *ActivityTypesService) DeleteActivityType(ctx context.Context, id int) error {
+	url := fmt.Sprintf("activitytypes/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListActivityTypeCategories Lists all activity type categories
+func (s *ActivityTypeCategoriesService) ListActivityTypeCategories(ctx context.Context, opts *ActivityTypeCategoryListOptions) (*[]*ActivityTypeCategory, *ListMeta, error) {
+	url, err := addOptions("activitytypecategories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listActivityTypeCategoriesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetActivityTypeCategory Retrieves a single activity type category
+func (s *ActivityTypeCategoriesService) GetActivityTypeCategory(ctx context.Context, id int) (*ActivityTypeCategory, error) {
+	url := fmt.Sprintf("activitytypecategories/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ActivityTypeCategory `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateActivityTypeCategory Creates an activity type category
+func (s *ActivityTypeCategoriesService) CreateActivityTypeCategory(ctx context.Context, input *ActivityTypeCategoryInput) (*ActivityTypeCategory, error) {
+	req, err := s.client.NewRequest("POST", "activitytypecategories", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ActivityTypeCategory `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateActivityTypeCategory Renames an activity type category
+func (s *ActivityTypeCategoriesService) UpdateActivityTypeCategory(ctx context.Context, id int, input *ActivityTypeCategoryInput) (*ActivityTypeCategory, error) {
+	url := fmt.Sprintf("activitytypecategories/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ActivityTypeCategory `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteActivityTypeCategory Deletes an activity type category
+func (s *ActivityTypeCategoriesService) DeleteActivityTypeCategory(ctx context.Context, id int) error {
+	url := fmt.Sprintf("activitytypecategories/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,322 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// AddressesService handles communication with the address related methods of the API.
+// API docs: https://www.monicahq.com/api/addresses
+type AddressesService service
+
+// PlacesService handles communication with the place related methods of the API.
+type PlacesService service
+
+// Address is a postal address of a contact
+type Address struct {
+	Id         int      `json:"id,omitempty"`
+	Object     string   `json:"object,omitempty"`
+	Name       string   `json:"name"`
+	Street     string   `json:"street"`
+	City       string   `json:"city"`
+	Province   string   `json:"province"`
+	PostalCode string   `json:"postal_code"`
+	Country    *Country `json:"country"`
+	Latitude   float64  `json:"latitude,omitempty"`
+	Longitude  float64  `json:"longitude,omitempty"`
+	Account    struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	// Contact is only set when addresses are not embedded in their contact
+	Contact *Contact `json:"contact,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type AddressInput struct {
+	// Name of the address, like "Home", max 255 characters
+	Name       string `json:"name,omitempty"`
+	Street     string `json:"street,omitempty"`
+	City       string `json:"city,omitempty"`
+	Province   string `json:"province,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	// Country is the Country.Id, see CountriesService.LookupCountryId
+	Country   string  `json:"country,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+	ContactId int     `json:"contact_id"`
+}
+
+// Place is a location not tied to a single contact, like the place of an
+// activity
+type Place struct {
+	Id         int      `json:"id,omitempty"`
+	Object     string   `json:"object,omitempty"`
+	Street     string   `json:"street"`
+	City       string   `json:"city"`
+	Province   string   `json:"province"`
+	PostalCode string   `json:"postal_code"`
+	Country    *Country `json:"country"`
+	Latitude   float64  `json:"latitude,omitempty"`
+	Longitude  float64  `json:"longitude,omitempty"`
+	Account    struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type PlaceInput struct {
+	Street     string `json:"street,omitempty"`
+	City       string `json:"city,omitempty"`
+	Province   string `json:"province,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	// Country is the Country.Id, see CountriesService.LookupCountryId
+	Country   string  `json:"country,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+type AddressListOptions struct {
+	ListOptions
+}
+
+type PlaceListOptions struct {
+	ListOptions
+}
+
+type listAddressesResponse struct {
+	Data *[]*Address `json:"data"`
+	Meta ListMeta    `json:"meta"`
+}
+
+type listPlacesResponse struct {
+	Data *[]*Place `json:"data"`
+	Meta ListMeta  `json:"meta"`
+}
+
+// ListAddresses Lists the addresses of all contacts
+func (s *AddressesService) ListAddresses(ctx context.Context, opts *AddressListOptions) (*[]*Address, *ListMeta, error) {
+	url, err := addOptions("addresses", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listAddressesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListAddressesForContact Lists the addresses of a single contact
+func (s *AddressesService) ListAddressesForContact(ctx context.Context, contactId int, opts *AddressListOptions) (*[]*Address, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/addresses", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listAddressesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetAddress Retrieves a single address
+func (s *AddressesService) GetAddress(ctx context.Context, id int) (*Address, error) {
+	url := fmt.Sprintf("addresses/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Address `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateAddress Adds an address to a contact
+func (s *AddressesService) CreateAddress(ctx context.Context, input *AddressInput) (*Address, error) {
+	req, err := s.client.NewRequest("POST", "addresses", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Address `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateAddress Updates an address
+func (s *AddressesService) UpdateAddress(ctx context.Context, id int, input *AddressInput) (*Address, error) {
+	url := fmt.Sprintf("addresses/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Address `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteAddress Deletes an address
+func (s *AddressesService) DeleteAddress(ctx context.Context, id int) error {
+	url := fmt.Sprintf("addresses/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllAddresses walks all pages of addresses starting at opts.Page and calls
+// fn for every address. Iteration stops at the first error returned by fn.
+func (s *AddressesService) ListAllAddresses(ctx context.Context, opts *AddressListOptions, fn func(*Address) error) error {
+	o := AddressListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var addresses *[]*Address
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		addresses, meta, err = s.ListAddresses(ctx, &o)
+		return meta, err
+	}, func() error {
+		if addresses == nil {
+			return nil
+		}
+		for _, address := range *addresses {
+			if err := fn(address); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListPlaces Lists all places
+func (s *PlacesService) ListPlaces(ctx context.Context, opts *PlaceListOptions) (*[]*Place, *ListMeta, error) {
+	url, err := addOptions("places", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listPlacesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetPlace Retrieves a single place
+func (s *PlacesService) GetPlace(ctx context.Context, id int) (*Place, error) {
+	url := fmt.Sprintf("places/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Place `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreatePlace Creates a place
+func (s *PlacesService) CreatePlace(ctx context.Context, input *PlaceInput) (*Place, error) {
+	req, err := s.client.NewRequest("POST", "places", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Place `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdatePlace Updates a place
+func (s *PlacesService) UpdatePlace(ctx context.Context, id int, input *PlaceInput) (*Place, error) {
+	url := fmt.Sprintf("places/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Place `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeletePlace Deletes a place
+func (s *PlacesService) DeletePlace(ctx context.Context, id int) error {
+	url := fmt.Sprintf("places/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
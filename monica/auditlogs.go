@@ -0,0 +1,200 @@
+package monica
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// AuditLogsService handles communication with the audit log related methods of the API.
+// API docs: https://www.monicahq.com/api/auditlogs
+type AuditLogsService service
+
+// AuditLogAction is the kind of change an audit log records. Monica adds new
+// actions over time, so values not listed here are to be expected.
+type AuditLogAction string
+
+const (
+	AuditAccountCreated            AuditLogAction = "account_created"
+	AuditContactCreated            AuditLogAction = "contact_created"
+	AuditContactUpdated            AuditLogAction = "contact_updated"
+	AuditContactDeleted            AuditLogAction = "contact_deleted"
+	AuditContactInformationUpdated AuditLogAction = "contact_information_updated"
+	AuditContactWorkUpdated        AuditLogAction = "contact_work_updated"
+	AuditFoodPreferencesUpdated    AuditLogAction = "food_preferences_updated"
+	AuditIntroductionsUpdated      AuditLogAction = "introductions_updated"
+	AuditAddressCreated            AuditLogAction = "address_created"
+	AuditAddressUpdated            AuditLogAction = "address_updated"
+	AuditAddressDeleted            AuditLogAction = "address_deleted"
+	AuditCallCreated               AuditLogAction = "call_created"
+	AuditCallUpdated               AuditLogAction = "call_updated"
+	AuditCallDeleted               AuditLogAction = "call_deleted"
+	AuditContactFieldCreated       AuditLogAction = "contact_field_created"
+	AuditContactFieldUpdated       AuditLogAction = "contact_field_updated"
+	AuditContactFieldDeleted       AuditLogAction = "contact_field_deleted"
+	AuditNoteCreated               AuditLogAction = "note_created"
+	AuditNoteUpdated               AuditLogAction = "note_updated"
+	AuditNoteDeleted               AuditLogAction = "note_deleted"
+	AuditRelationshipCreated       AuditLogAction = "relationship_created"
+	AuditRelationshipDeleted       AuditLogAction = "relationship_deleted"
+	AuditReminderCreated           AuditLogAction = "reminder_created"
+	AuditReminderUpdated           AuditLogAction = "reminder_updated"
+	AuditReminderDeleted           AuditLogAction = "reminder_deleted"
+	AuditTaskCreated               AuditLogAction = "task_created"
+	AuditTaskUpdated               AuditLogAction = "task_updated"
+	AuditTaskDeleted               AuditLogAction = "task_deleted"
+)
+
+type AuditLog struct {
+	Id     int            `json:"id,omitempty"`
+	Object string         `json:"object,omitempty"`
+	Author AuditLogAuthor `json:"author"`
+	Action AuditLogAction `json:"action"`
+	// Objects describes the changed objects, its content depends on Action
+	Objects   AuditLogObjects `json:"objects"`
+	AuditedAt Timestamp       `json:"audited_at"`
+	Account   struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// AuditLogAuthor is the user who made a change. Id is 0 for changes not made
+// by a user, like the ones of scheduled jobs.
+type AuditLogAuthor struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// AuditLogObjects holds the objects changed by an audited action. The fields
+// are set when present in the log, Raw always holds the complete document.
+type AuditLogObjects struct {
+	ContactId   int
+	ContactName string
+	Raw         json.RawMessage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Ids are accepted as numbers or numeric strings.
+func (o *AuditLogObjects) UnmarshalJSON(data []byte) error {
+	*o = AuditLogObjects{}
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	o.Raw = append(json.RawMessage(nil), data...)
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		// objects of old logs may be a plain value, keep them in Raw only
+		return nil
+	}
+
+	if raw, ok := fields["contact_id"]; ok {
+		id, err := strconv.Atoi(string(bytes.Trim(raw, `"`)))
+		if err == nil {
+			o.ContactId = id
+		}
+	}
+	if raw, ok := fields["contact_name"]; ok {
+		_ = json.Unmarshal(raw, &o.ContactName)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding Raw.
+func (o AuditLogObjects) MarshalJSON() ([]byte, error) {
+	if len(o.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return o.Raw, nil
+}
+
+// Decode unmarshals the complete objects document into v, for actions whose
+// objects carry more than the common fields.
+func (o *AuditLogObjects) Decode(v interface{}) error {
+	if len(o.Raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(o.Raw, v)
+}
+
+type AuditLogListOptions struct {
+	ListOptions
+}
+
+type listAuditLogsResponse struct {
+	Data *[]*AuditLog `json:"data"`
+	Meta ListMeta     `json:"meta"`
+}
+
+// ListAuditLogs Lists the audit logs of the account
+func (s *AuditLogsService) ListAuditLogs(ctx context.Context, opts *AuditLogListOptions) (*[]*AuditLog, *ListMeta, error) {
+	url, err := addOptions("logs", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listAuditLogsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListAuditLogsForContact Lists the audit logs of a single contact
+func (s *AuditLogsService) ListAuditLogsForContact(ctx context.Context, contactId int, opts *AuditLogListOptions) (*[]*AuditLog, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/logs", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listAuditLogsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListAllAuditLogs walks all pages of audit logs starting at opts.Page and
+// calls fn for every log. Iteration stops at the first error returned by fn.
+func (s *AuditLogsService) ListAllAuditLogs(ctx context.Context, opts *AuditLogListOptions, fn func(*AuditLog) error) error {
+	o := AuditLogListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var logs *[]*AuditLog
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		logs, meta, err = s.ListAuditLogs(ctx, &o)
+		return meta, err
+	}, func() error {
+		if logs == nil {
+			return nil
+		}
+		for _, log := range *logs {
+			if err := fn(log); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,182 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// CallsService handles communication with the call related methods of the API.
+// API docs: https://www.monicahq.com/api/calls
+type CallsService service
+
+type Call struct {
+	Id       int       `json:"id,omitempty"`
+	Object   string    `json:"object,omitempty"`
+	CalledAt Timestamp `json:"called_at"`
+	Content  string    `json:"content"`
+	// ContactCalled is true if the contact called, false if the user did
+	ContactCalled bool       `json:"contact_called"`
+	Emotions      []*Emotion `json:"emotions"`
+	Account       struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type CallInput struct {
+	// Content describes what the call was about, max 100000 characters
+	Content       string    `json:"content,omitempty"`
+	ContactId     int       `json:"contact_id"`
+	CalledAt      Timestamp `json:"called_at"`
+	ContactCalled bool      `json:"contact_called"`
+	// Emotions are the ids of the emotions felt during the call
+	Emotions []int `json:"emotions,omitempty"`
+}
+
+type CallListOptions struct {
+	ListOptions
+}
+
+type listCallsResponse struct {
+	Data *[]*Call `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// ListCalls Lists the calls of all contacts
+func (s *CallsService) ListCalls(ctx context.Context, opts *CallListOptions) (*[]*Call, *ListMeta, error) {
+	url, err := addOptions("calls", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listCallsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListCallsForContact Lists the calls of a single contact
+func (s *CallsService) ListCallsForContact(ctx context.Context, contactId int, opts *CallListOptions) (*[]*Call, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/calls", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listCallsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetCall Retrieves a single call
+func (s *CallsService) GetCall(ctx context.Context, id int) (*Call, error) {
+	url := fmt.Sprintf("calls/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Call `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateCall Logs a call, which also updates the last_called date of the
+// contact
+func (s *CallsService) CreateCall(ctx context.Context, input *CallInput) (*Call, error) {
+	req, err := s.client.NewRequest("POST", "calls", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Call `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateCall Updates a call
+func (s *CallsService) UpdateCall(ctx context.Context, id int, input *CallInput) (*Call, error) {
+	url := fmt.Sprintf("calls/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Call `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteCall Deletes a call
+func (s *CallsService) DeleteCall(ctx context.Context, id int) error {
+	url := fmt.Sprintf("calls/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllCalls walks all pages of calls starting at opts.Page and calls fn for
+// every call. Iteration stops at the first error returned by fn.
+func (s *CallsService) ListAllCalls(ctx context.Context, opts *CallListOptions, fn func(*Call) error) error {
+	o := CallListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var calls *[]*Call
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		calls, meta, err = s.ListCalls(ctx, &o)
+		return meta, err
+	}, func() error {
+		if calls == nil {
+			return nil
+		}
+		for _, call := range *calls {
+			if err := fn(call); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -2,12 +2,17 @@ package monica
 
 import (
 	"context"
+	"fmt"
 )
 
 // ContactFieldTypeService handles communication with the contactfieldType related methods of the API.
 // API docs: https://www.monicahq.com/api/contactfieldtypes
 type ContactFieldTypeService service
 
+// ContactFieldsService handles communication with the contactfield related methods of the API.
+// API docs: https://www.monicahq.com/api/contactfields
+type ContactFieldsService service
+
 type ContactFieldType struct {
 	// Id is the unique id of the contact field type
 	Id int `json:"id"`
@@ -44,10 +49,44 @@ type ContactField struct {
 	UpdatedAt Timestamp `json:"updated_at,omitempty"`
 }
 
+// ContactFieldTypeInput is used to create or update custom contact field types
+type ContactFieldTypeInput struct {
+	// Name is the displayed name, max 255 characters
+	Name string `json:"name"`
+	// FontawesomeIcon is a reference to a fontawesome icon, max 255 characters
+	FontawesomeIcon string `json:"fontawesome_icon,omitempty"`
+	// Protocol is prepended to the field data to create a link, like "mailto:"
+	Protocol string `json:"protocol,omitempty"`
+	// Delible defines whether the contactFieldType is deletable. If nil, new
+	// types are deletable and updates keep the current value.
+	Delible *bool  `json:"delible,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// ContactFieldInput is used to create or update contact fields
+type ContactFieldInput struct {
+	ContactFieldTypeId int `json:"contact_field_type_id"`
+	ContactId          int `json:"contact_id"`
+	// Data value of the contact field, max 255 characters
+	Data string `json:"data"`
+}
+
+// CreateContactFieldInput is the former name of ContactFieldInput
+type CreateContactFieldInput = ContactFieldInput
+
 type ContactFieldTypeListOptions struct {
 	ListOptions
 }
 
+type ContactFieldListOptions struct {
+	ListOptions
+}
+
+type listContactFieldResponse struct {
+	Data *[]*ContactField `json:"data"`
+	Meta ListMeta         `json:"meta"`
+}
+
 type listContactFieldTypeResponse struct {
 	Data *[]*ContactFieldType ` json:"data"`
 	Meta ListMeta             `json:"meta"`
@@ -72,3 +111,187 @@ func (s *ContactFieldTypeService) ListContactFieldTypes(ctx context.Context, opt
 
 	return response.Data, &response.Meta, nil
 }
+
+// ListAllContactFieldTypes walks all pages of contact field types starting at
+// opts.Page and calls fn for every type. Iteration stops at the first error
+// returned by fn.
+func (s *ContactFieldTypeService) ListAllContactFieldTypes(ctx context.Context, opts *ContactFieldTypeListOptions, fn func(*ContactFieldType) error) error {
+	o := ContactFieldTypeListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var types *[]*ContactFieldType
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		types, meta, err = s.ListContactFieldTypes(ctx, &o)
+		return meta, err
+	}, func() error {
+		if types == nil {
+			return nil
+		}
+		for _, fieldType := range *types {
+			if err := fn(fieldType); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetContactFieldType Retrieves a single contact field type
+func (s *ContactFieldTypeService) GetContactFieldType(ctx context.Context, id int) (*ContactFieldType, error) {
+	url := fmt.Sprintf("contactfieldtypes/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ContactFieldType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateContactFieldType Creates a custom contact field type
+func (s *ContactFieldTypeService) CreateContactFieldType(ctx context.Context, input *ContactFieldTypeInput) (*ContactFieldType, error) {
+	req, err := s.client.NewRequest("POST", "contactfieldtypes", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ContactFieldType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateContactFieldType Updates a custom contact field type
+func (s *ContactFieldTypeService) UpdateContactFieldType(ctx context.Context, id int, input *ContactFieldTypeInput) (*ContactFieldType, error) {
+	url := fmt.Sprintf("contactfieldtypes/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ContactFieldType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteContactFieldType Deletes a custom contact field type, only possible
+// if it is Delible
+func (s *ContactFieldTypeService) DeleteContactFieldType(ctx context.Context, id int) error {
+	url := fmt.Sprintf("contactfieldtypes/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// GetContactField Retrieves a single contact field
+func (s *ContactFieldsService) GetContactField(ctx context.Context, id int) (*ContactField, error) {
+	url := fmt.Sprintf("contactfields/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ContactField `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListContactFieldsForContact Lists the contact fields of a single contact
+func (s *ContactFieldsService) ListContactFieldsForContact(ctx context.Context, contactId int, opts *ContactFieldListOptions) (*[]*ContactField, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/contactfields", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listContactFieldResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// CreateContactField Adds a contact field, like an email address, to a contact
+func (s *ContactFieldsService) CreateContactField(ctx context.Context, input *ContactFieldInput) (*ContactField, error) {
+	req, err := s.client.NewRequest("POST", "contactfields", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ContactField `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateContactField Updates a contact field
+func (s *ContactFieldsService) UpdateContactField(ctx context.Context, id int, input *ContactFieldInput) (*ContactField, error) {
+	url := fmt.Sprintf("contactfields/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *ContactField `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteContactField Deletes a contact field
+func (s *ContactFieldsService) DeleteContactField(ctx context.Context, id int) error {
+	url := fmt.Sprintf("contactfields/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
@@ -3,6 +3,7 @@ package monica
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // ContactsService handles communication with the tag related methods of the API.
@@ -36,6 +37,124 @@ type Contact struct {
 	DeceasedDateYear       int  `json:"deceased_date_year,omitempty"`
 	DeceasedDateIsAgeBased bool `json:"deceased_date_is_age_based,omitempty"`
 	IsDeceasedDateKnown    bool `json:"is_deceased_date_known"`
+
+	CompleteName string `json:"complete_name,omitempty"`
+	Initials     string `json:"initials,omitempty"`
+	GenderType   string `json:"gender_type,omitempty"`
+	IsStarred    bool   `json:"is_starred"`
+	IsActive     bool   `json:"is_active"`
+	IsDead       bool   `json:"is_dead"`
+	IsMe         bool   `json:"is_me"`
+
+	// LastCalled is the date of the most recent call logged with CallsService
+	LastCalled           Timestamp `json:"last_called,omitempty"`
+	LastActivityTogether Timestamp `json:"last_activity_together,omitempty"`
+	// StayInTouchFrequency is the number of days between stay in touch reminders
+	StayInTouchFrequency   int       `json:"stay_in_touch_frequency,omitempty"`
+	StayInTouchTriggerDate Timestamp `json:"stay_in_touch_trigger_date,omitempty"`
+
+	Information *ContactInformation `json:"information,omitempty"`
+	Addresses   []*Address          `json:"addresses,omitempty"`
+	Tags        []*Tag              `json:"tags,omitempty"`
+	Statistics  *ContactStatistics  `json:"statistics,omitempty"`
+	// ContactFields is only set when requested with ContactWithContactFields
+	ContactFields []*ContactField `json:"contactFields,omitempty"`
+
+	Url     string `json:"url,omitempty"`
+	Account struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// ContactInformation holds the detailed information of a full contact
+type ContactInformation struct {
+	Relationships   ContactRelationships `json:"relationships"`
+	Dates           ContactDates         `json:"dates"`
+	Career          ContactCareer        `json:"career"`
+	Avatar          ContactAvatar        `json:"avatar"`
+	FoodPreferences string               `json:"food_preferences"`
+	HowYouMet       ContactHowYouMet     `json:"how_you_met"`
+}
+
+// ContactRelationships holds the relationships of a contact, by relationship
+// type group
+type ContactRelationships struct {
+	Love   ContactRelationshipGroup `json:"love"`
+	Family ContactRelationshipGroup `json:"family"`
+	Friend ContactRelationshipGroup `json:"friend"`
+	Work   ContactRelationshipGroup `json:"work"`
+}
+
+type ContactRelationshipGroup struct {
+	Total    int                         `json:"total"`
+	Contacts []*ContactRelationshipEntry `json:"contacts"`
+}
+
+// ContactRelationshipEntry is a related contact along with the kind of the
+// relationship
+type ContactRelationshipEntry struct {
+	Relationship struct {
+		Id   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"relationship"`
+	Contact Contact `json:"contact"`
+}
+
+type ContactDates struct {
+	Birthdate    SpecialDate `json:"birthdate"`
+	DeceasedDate SpecialDate `json:"deceased_date"`
+}
+
+// SpecialDate is a date that might only be partially known, like a birthdate
+// without a year
+type SpecialDate struct {
+	IsAgeBased    bool      `json:"is_age_based"`
+	IsYearUnknown bool      `json:"is_year_unknown"`
+	Date          Timestamp `json:"date"`
+}
+
+type ContactCareer struct {
+	Job     string `json:"job"`
+	Company string `json:"company"`
+}
+
+// AvatarSource defines where the avatar of a contact comes from
+type AvatarSource string
+
+const (
+	// AvatarDefault shows the initials of the contact
+	AvatarDefault  AvatarSource = "default"
+	AvatarAdorable AvatarSource = "adorable"
+	AvatarGravatar AvatarSource = "gravatar"
+	// AvatarPhoto uses a photo uploaded to the contact
+	AvatarPhoto AvatarSource = "photo"
+)
+
+type ContactAvatar struct {
+	// Url is the resolved image URL of the current source
+	Url                string       `json:"url"`
+	Source             AvatarSource `json:"source"`
+	DefaultAvatarColor string       `json:"default_avatar_color"`
+}
+
+type ContactHowYouMet struct {
+	GeneralInformation     string      `json:"general_information"`
+	FirstMetDate           SpecialDate `json:"first_met_date"`
+	FirstMetThroughContact *Contact    `json:"first_met_through_contact"`
+}
+
+// ContactStatistics holds the number of resources attached to a contact
+type ContactStatistics struct {
+	NumberOfCalls      int `json:"number_of_calls"`
+	NumberOfNotes      int `json:"number_of_notes"`
+	NumberOfActivities int `json:"number_of_activities"`
+	NumberOfReminders  int `json:"number_of_reminders"`
+	NumberOfTasks      int `json:"number_of_tasks"`
+	NumberOfGifts      int `json:"number_of_gifts"`
+	NumberOfDebts      int `json:"number_of_debts"`
 }
 
 type ContactInput struct {
@@ -64,7 +183,40 @@ type ContactInput struct {
 
 type ContactSearchListOptions struct {
 	ListOptions
-	Query string `url:"query,omitempty""`
+	Query string `url:"query,omitempty"`
+}
+
+const (
+	// ContactWithContactFields includes the contact fields in a contact
+	ContactWithContactFields = "contactfields"
+)
+
+type ContactGetOptions struct {
+	// With expands related resources, see ContactWithContactFields
+	With string `url:"with,omitempty"`
+}
+
+// ContactSort is a sort criteria for contact lists, a leading "-" sorts
+// descending
+type ContactSort string
+
+const (
+	ContactSortCreatedAt     ContactSort = "created_at"
+	ContactSortCreatedAtDesc ContactSort = "-created_at"
+	ContactSortUpdatedAt     ContactSort = "updated_at"
+	ContactSortUpdatedAtDesc ContactSort = "-updated_at"
+)
+
+type ContactListOptions struct {
+	ListOptions
+	// Query restricts the list to contacts matching the search term
+	Query string      `url:"query,omitempty"`
+	Sort  ContactSort `url:"sort,omitempty"`
+	// Partial restricts the list to partial contacts, which are contacts only
+	// existing as relatives of other contacts
+	Partial bool `url:"is_partial,omitempty"`
+	// With expands related resources, see ContactWithContactFields
+	With string `url:"with,omitempty"`
 }
 
 type listContactsResponse struct {
@@ -77,11 +229,9 @@ type updateContactCareerInput struct {
 	Company string `json:"company,omitempty"`
 }
 
-type CreateContactFieldInput struct {
-	ContactFieldTypeId int `json:"contact_field_type_id"`
-	ContactId          int `json:"contact_id"`
-	// Data value of the contact field, max 255 characters
-	Data string `json:"data"`
+type updateContactAvatarInput struct {
+	Source  AvatarSource `json:"source"`
+	PhotoId int          `json:"photo_id,omitempty"`
 }
 
 type addTagInput struct {
@@ -106,23 +256,38 @@ func (s *ContactsService) CreateContact(ctx context.Context, input *ContactInput
 	return response.Data, nil
 }
 
-func (s *ContactsService) DeleteContact(ctx context.Context, id int) error {
-	url := fmt.Sprintf("contacts/%d", id)
-	req, err := s.client.NewRequest("DELETE", url, nil)
+// GetContact Retrieves a single contact
+func (s *ContactsService) GetContact(ctx context.Context, id int, opts *ContactGetOptions) (*Contact, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d", id), opts)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
 	}
 
 	response := struct {
-		Deleted bool   `json:"deleted"`
-		Id      string `json:"id"`
+		Data *Contact `json:"data"`
 	}{}
 	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+func (s *ContactsService) DeleteContact(ctx context.Context, id int) error {
+	url := fmt.Sprintf("contacts/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	_, err = s.client.Do(ctx, req, nil)
+	return err
 }
 
 func (s *ContactsService) SearchContacts(ctx context.Context, opts *ContactSearchListOptions) (*[]*Contact, *ListMeta, error) {
@@ -145,6 +310,29 @@ func (s *ContactsService) SearchContacts(ctx context.Context, opts *ContactSearc
 	return response.Data, &response.Meta, nil
 }
 
+// ListContacts Lists contacts, optionally filtered and sorted
+func (s *ContactsService) ListContacts(ctx context.Context, opts *ContactListOptions) (*[]*Contact, *ListMeta, error) {
+	url, err := addOptions("contacts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listContactsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// UpdateContactCareer Sets the free-text job and company of a contact. To
+// record a full work history, use OccupationsService instead.
 func (s *ContactsService) UpdateContactCareer(ctx context.Context, contactId int, job string, company string) (*Contact, error) {
 	url := fmt.Sprintf("contacts/%d/work", contactId)
 	body := updateContactCareerInput{
@@ -187,14 +375,22 @@ func (s *ContactsService) UpdateContact(ctx context.Context, contactId int, cont
 	return response.Data, nil
 }
 
+// CreateContactField Adds a contact field to a contact
+//
+// Deprecated: use ContactFieldsService.CreateContactField
 func (s *ContactsService) CreateContactField(ctx context.Context, input *CreateContactFieldInput) (*ContactField, error) {
-	req, err := s.client.NewRequest("POST", "contactfields", input)
+	return (*ContactFieldsService)(s).CreateContactField(ctx, input)
+}
+
+func (s *ContactsService) AddTags(ctx context.Context, contactId int, tags []string) (*Contact, error) {
+	url := fmt.Sprintf("contacts/%d/setTags", contactId)
+	req, err := s.client.NewRequest("POST", url, addTagInput{Tags: tags})
 	if err != nil {
 		return nil, err
 	}
 
 	response := struct {
-		Data *ContactField `json:"data"`
+		Data *Contact `json:"data"`
 	}{}
 
 	_, err = s.client.Do(ctx, req, &response)
@@ -205,9 +401,78 @@ func (s *ContactsService) CreateContactField(ctx context.Context, input *CreateC
 	return response.Data, nil
 }
 
-func (s *ContactsService) AddTags(ctx context.Context, contactId int, tags []string) (*Contact, error) {
-	url := fmt.Sprintf("contacts/%d/setTags", contactId)
-	req, err := s.client.NewRequest("POST", url, addTagInput{Tags: tags})
+// SearchAllContacts walks all pages of the contact search starting at
+// opts.Page and calls fn for every contact. Iteration stops at the first error
+// returned by fn.
+func (s *ContactsService) SearchAllContacts(ctx context.Context, opts *ContactSearchListOptions, fn func(*Contact) error) error {
+	o := ContactSearchListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var contacts *[]*Contact
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		contacts, meta, err = s.SearchContacts(ctx, &o)
+		return meta, err
+	}, func() error {
+		if contacts == nil {
+			return nil
+		}
+		for _, contact := range *contacts {
+			if err := fn(contact); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListAllContacts walks all pages of contacts starting at opts.Page and calls
+// fn for every contact. Iteration stops at the first error returned by fn.
+func (s *ContactsService) ListAllContacts(ctx context.Context, opts *ContactListOptions, fn func(*Contact) error) error {
+	o := ContactListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var contacts *[]*Contact
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		contacts, meta, err = s.ListContacts(ctx, &o)
+		return meta, err
+	}, func() error {
+		if contacts == nil {
+			return nil
+		}
+		for _, contact := range *contacts {
+			if err := fn(contact); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AvatarUrl returns the resolved URL of the contact's avatar, or an empty
+// string if the contact has been fetched without its information block.
+func (c *Contact) AvatarUrl() string {
+	if c.Information == nil {
+		return ""
+	}
+	return c.Information.Avatar.Url
+}
+
+// UpdateContactAvatar Sets the avatar source of a contact. photoId is the id
+// of a photo of the contact and only used with AvatarPhoto.
+func (s *ContactsService) UpdateContactAvatar(ctx context.Context, contactId int, source AvatarSource, photoId int) (*Contact, error) {
+	url := fmt.Sprintf("contacts/%d/avatar", contactId)
+	body := updateContactAvatarInput{
+		Source: source,
+	}
+	if source == AvatarPhoto {
+		body.PhotoId = photoId
+	}
+
+	req, err := s.client.NewRequest("PUT", url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -215,7 +480,6 @@ func (s *ContactsService) AddTags(ctx context.Context, contactId int, tags []str
 	response := struct {
 		Data *Contact `json:"data"`
 	}{}
-
 	_, err = s.client.Do(ctx, req, &response)
 	if err != nil {
 		return nil, err
@@ -223,3 +487,16 @@ func (s *ContactsService) AddTags(ctx context.Context, contactId int, tags []str
 
 	return response.Data, nil
 }
+
+// UploadContactAvatar Uploads a photo to a contact and uses it as avatar
+func (s *ContactsService) UploadContactAvatar(ctx context.Context, contactId int, filename string, file io.Reader) (*Contact, error) {
+	photo, err := (*PhotosService)(s).UploadPhoto(ctx, contactId, filename, file)
+	if err != nil {
+		return nil, err
+	}
+	if photo == nil {
+		return nil, ErrEmptyResponse
+	}
+
+	return s.UpdateContactAvatar(ctx, contactId, AvatarPhoto, photo.Id)
+}
@@ -0,0 +1,256 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConversationsService handles communication with the conversation related methods of the API.
+// API docs: https://www.monicahq.com/api/conversations
+type ConversationsService service
+
+type Conversation struct {
+	Id         int        `json:"id,omitempty"`
+	Object     string     `json:"object,omitempty"`
+	HappenedAt Timestamp  `json:"happened_at"`
+	Messages   []*Message `json:"messages"`
+	// ContactFieldType is the channel of the conversation, like "Email"
+	ContactFieldType ContactFieldType `json:"contact_field_type"`
+	Account          struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// Message is a single message of a Conversation
+type Message struct {
+	Id        int       `json:"id,omitempty"`
+	Object    string    `json:"object,omitempty"`
+	Content   string    `json:"content"`
+	WrittenAt Timestamp `json:"written_at"`
+	// WrittenByMe is true for messages of the user, false for messages of
+	// the contact
+	WrittenByMe bool `json:"written_by_me"`
+	Account     struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type ConversationInput struct {
+	HappenedAt Timestamp `json:"happened_at"`
+	// ContactFieldTypeId is the id of the ContactFieldType used as channel
+	ContactFieldTypeId int `json:"contact_field_type_id"`
+	ContactId          int `json:"contact_id"`
+}
+
+type MessageInput struct {
+	ContactId   int       `json:"contact_id"`
+	WrittenAt   Timestamp `json:"written_at"`
+	WrittenByMe bool      `json:"written_by_me"`
+	// Content of the message, max 1000000 characters
+	Content string `json:"content"`
+}
+
+type ConversationListOptions struct {
+	ListOptions
+}
+
+type listConversationsResponse struct {
+	Data *[]*Conversation `json:"data"`
+	Meta ListMeta         `json:"meta"`
+}
+
+// ListConversations Lists the conversations with all contacts
+func (s *ConversationsService) ListConversations(ctx context.Context, opts *ConversationListOptions) (*[]*Conversation, *ListMeta, error) {
+	url, err := addOptions("conversations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listConversationsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListConversationsForContact Lists the conversations with a single contact
+func (s *ConversationsService) ListConversationsForContact(ctx context.Context, contactId int, opts *ConversationListOptions) (*[]*Conversation, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/conversations", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listConversationsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetConversation Retrieves a single conversation including its messages
+func (s *ConversationsService) GetConversation(ctx context.Context, id int) (*Conversation, error) {
+	url := fmt.Sprintf("conversations/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Conversation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateConversation Creates an empty conversation, add messages with
+// AddMessage
+func (s *ConversationsService) CreateConversation(ctx context.Context, input *ConversationInput) (*Conversation, error) {
+	req, err := s.client.NewRequest("POST", "conversations", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Conversation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateConversation Updates the date and channel of a conversation
+func (s *ConversationsService) UpdateConversation(ctx context.Context, id int, input *ConversationInput) (*Conversation, error) {
+	url := fmt.Sprintf("conversations/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Conversation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteConversation Deletes a conversation with all its messages
+func (s *ConversationsService) DeleteConversation(ctx context.Context, id int) error {
+	url := fmt.Sprintf("conversations/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// AddMessage Adds a message to a conversation, returns the updated conversation
+func (s *ConversationsService) AddMessage(ctx context.Context, conversationId int, input *MessageInput) (*Conversation, error) {
+	url := fmt.Sprintf("conversations/%d/messages", conversationId)
+	req, err := s.client.NewRequest("POST", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Conversation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateMessage Updates a message of a conversation, returns the updated
+// conversation
+func (s *ConversationsService) UpdateMessage(ctx context.Context, conversationId int, messageId int, input *MessageInput) (*Conversation, error) {
+	url := fmt.Sprintf("conversations/%d/messages/%d", conversationId, messageId)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Conversation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteMessage Deletes a message of a conversation
+func (s *ConversationsService) DeleteMessage(ctx context.Context, conversationId int, messageId int) error {
+	url := fmt.Sprintf("conversations/%d/messages/%d", conversationId, messageId)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllConversations walks all pages of conversations starting at opts.Page
+// and calls fn for every conversation. Iteration stops at the first error
+// returned by fn.
+func (s *ConversationsService) ListAllConversations(ctx context.Context, opts *ConversationListOptions, fn func(*Conversation) error) error {
+	o := ConversationListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var conversations *[]*Conversation
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		conversations, meta, err = s.ListConversations(ctx, &o)
+		return meta, err
+	}, func() error {
+		if conversations == nil {
+			return nil
+		}
+		for _, conversation := range *conversations {
+			if err := fn(conversation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
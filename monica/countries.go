@@ -1,6 +1,11 @@
 package monica
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // CountriesService handles communication with the country related
 // methods of the Monica API.
@@ -15,6 +20,24 @@ type Country struct {
 	Iso    string `json:"iso"`
 }
 
+// ErrUnknownCountry is returned when a country lookup has no match
+var ErrUnknownCountry = errors.New("unknown country")
+
+// countryAliases maps common alternative names to Country.Id
+var countryAliases = map[string]string{
+	"usa":                      "US",
+	"united states of america": "US",
+	"america":                  "US",
+	"uk":                       "GB",
+	"great britain":            "GB",
+	"england":                  "GB",
+	"scotland":                 "GB",
+	"wales":                    "GB",
+	"deutschland":              "DE",
+	"holland":                  "NL",
+	"the netherlands":          "NL",
+}
+
 type listCountryResponse struct {
 	Countries map[string]*Country `json:"data"`
 }
@@ -42,3 +65,70 @@ func (s *CountriesService) ListCountries(ctx context.Context, opts *CountryListO
 
 	return &countryList.Countries, nil
 }
+
+// CachedCountries returns all countries, fetching them only on the first call.
+// The result is shared, it must not be modified.
+func (s *CountriesService) CachedCountries(ctx context.Context) (map[string]*Country, error) {
+	s.client.countriesMu.Lock()
+	defer s.client.countriesMu.Unlock()
+
+	if s.client.countries != nil {
+		return s.client.countries, nil
+	}
+
+	countries, err := s.ListCountries(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.client.countries = *countries
+	return s.client.countries, nil
+}
+
+// LookupCountryId resolves an ISO code or a country name, as found in
+// free-form data, to the Country.Id expected by address inputs. Matching is
+// case-insensitive. The country list is cached, see CachedCountries.
+func (s *CountriesService) LookupCountryId(ctx context.Context, query string) (string, error) {
+	countries, err := s.CachedCountries(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	country := FindCountry(countries, query)
+	if country == nil {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCountry, query)
+	}
+
+	return country.Id, nil
+}
+
+// FindCountry returns the country whose id, ISO code or name matches query,
+// ignoring case and surrounding whitespace, or nil if none does. A few common
+// alternative names like "USA" or "UK" are recognized as well.
+func FindCountry(countries map[string]*Country, query string) *Country {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil
+	}
+
+	for _, country := range countries {
+		if strings.EqualFold(country.Id, query) || strings.EqualFold(country.Iso, query) {
+			return country
+		}
+	}
+	for _, country := range countries {
+		if strings.EqualFold(country.Name, query) {
+			return country
+		}
+	}
+
+	if id, ok := countryAliases[strings.ToLower(query)]; ok {
+		for _, country := range countries {
+			if strings.EqualFold(country.Id, id) {
+				return country
+			}
+		}
+	}
+
+	return nil
+}
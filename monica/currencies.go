@@ -0,0 +1,94 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// CurrenciesService handles communication with the currency related methods of the API.
+// API docs: https://www.monicahq.com/api/currencies
+type CurrenciesService service
+
+type Currency struct {
+	Id     int    `json:"id,omitempty"`
+	Object string `json:"object,omitempty"`
+	// Iso is the ISO 4217 code, like "EUR"
+	Iso    string `json:"iso"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+type CurrencyListOptions struct {
+	ListOptions
+}
+
+type listCurrenciesResponse struct {
+	Data *[]*Currency `json:"data"`
+	Meta ListMeta     `json:"meta"`
+}
+
+// ListCurrencies Lists all currencies
+func (s *CurrenciesService) ListCurrencies(ctx context.Context, opts *CurrencyListOptions) (*[]*Currency, *ListMeta, error) {
+	url, err := addOptions("currencies", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listCurrenciesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetCurrency Retrieves a single currency
+func (s *CurrenciesService) GetCurrency(ctx context.Context, id int) (*Currency, error) {
+	url := fmt.Sprintf("currencies/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Currency `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListAllCurrencies walks all pages of currencies starting at opts.Page and
+// calls fn for every currency. Iteration stops at the first error returned by
+// fn.
+func (s *CurrenciesService) ListAllCurrencies(ctx context.Context, opts *CurrencyListOptions, fn func(*Currency) error) error {
+	o := CurrencyListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var currencies *[]*Currency
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		currencies, meta, err = s.ListCurrencies(ctx, &o)
+		return meta, err
+	}, func() error {
+		if currencies == nil {
+			return nil
+		}
+		for _, currency := range *currencies {
+			if err := fn(currency); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,205 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// DebtsService handles communication with the debt related methods of the API.
+// API docs: https://www.monicahq.com/api/debts
+type DebtsService service
+
+// DebtDirection tells who owes whom, Monica calls it in_debt
+type DebtDirection string
+
+const (
+	// DebtYouOwe means the user owes the contact
+	DebtYouOwe DebtDirection = "yes"
+	// DebtOwesYou means the contact owes the user
+	DebtOwesYou DebtDirection = "no"
+)
+
+type DebtStatus string
+
+const (
+	DebtInProgress DebtStatus = "inprogress"
+	DebtComplete   DebtStatus = "complete"
+)
+
+type Debt struct {
+	Id     int           `json:"id,omitempty"`
+	Object string        `json:"object,omitempty"`
+	InDebt DebtDirection `json:"in_debt"`
+	Status DebtStatus    `json:"status"`
+	Amount Amount        `json:"amount"`
+	// Currency is nil if the account has no currency set
+	Currency *Currency `json:"currency"`
+	// AmountWithCurrency is the amount formatted in the account's currency
+	AmountWithCurrency string `json:"amount_with_currency"`
+	Reason             string `json:"reason"`
+	Account            struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// Money returns the amount of the debt along with its currency
+func (d *Debt) Money() Money {
+	return Money{Amount: d.Amount, Currency: d.Currency}
+}
+
+type DebtInput struct {
+	InDebt DebtDirection `json:"in_debt"`
+	Status DebtStatus    `json:"status"`
+	Amount float64       `json:"amount"`
+	// Reason of the debt, max 1000000 characters
+	Reason    string `json:"reason,omitempty"`
+	ContactId int    `json:"contact_id"`
+}
+
+type DebtListOptions struct {
+	ListOptions
+}
+
+type listDebtsResponse struct {
+	Data *[]*Debt `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// ListDebts Lists the debts of all contacts
+func (s *DebtsService) ListDebts(ctx context.Context, opts *DebtListOptions) (*[]*Debt, *ListMeta, error) {
+	url, err := addOptions("debts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listDebtsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListDebtsForContact Lists the debts of a single contact
+func (s *DebtsService) ListDebtsForContact(ctx context.Context, contactId int, opts *DebtListOptions) (*[]*Debt, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/debts", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listDebtsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetDebt Retrieves a single debt
+func (s *DebtsService) GetDebt(ctx context.Context, id int) (*Debt, error) {
+	url := fmt.Sprintf("debts/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Debt `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateDebt Records a debt between the user and a contact
+func (s *DebtsService) CreateDebt(ctx context.Context, input *DebtInput) (*Debt, error) {
+	req, err := s.client.NewRequest("POST", "debts", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Debt `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateDebt Updates a debt
+func (s *DebtsService) UpdateDebt(ctx context.Context, id int, input *DebtInput) (*Debt, error) {
+	url := fmt.Sprintf("debts/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Debt `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteDebt Deletes a debt
+func (s *DebtsService) DeleteDebt(ctx context.Context, id int) error {
+	url := fmt.Sprintf("debts/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllDebts walks all pages of debts starting at opts.Page and calls fn for
+// every debt. Iteration stops at the first error returned by fn.
+func (s *DebtsService) ListAllDebts(ctx context.Context, opts *DebtListOptions, fn func(*Debt) error) error {
+	o := DebtListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var debts *[]*Debt
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		debts, meta, err = s.ListDebts(ctx, &o)
+		return meta, err
+	}, func() error {
+		if debts == nil {
+			return nil
+		}
+		for _, debt := range *debts {
+			if err := fn(debt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
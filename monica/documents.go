@@ -0,0 +1,144 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// DocumentsService handles communication with the document related methods of the API.
+// API docs: https://www.monicahq.com/api/documents
+type DocumentsService service
+
+type Document struct {
+	Id               int    `json:"id,omitempty"`
+	Object           string `json:"object,omitempty"`
+	OriginalFilename string `json:"original_filename"`
+	NewFilename      string `json:"new_filename"`
+	// Filesize is the size in bytes
+	Filesize          int    `json:"filesize"`
+	Type              string `json:"type"`
+	MimeType          string `json:"mime_type"`
+	NumberOfDownloads int    `json:"number_of_downloads"`
+	// Link is the URL of the file content, see DownloadDocument
+	Link    string `json:"link"`
+	Account struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type DocumentListOptions struct {
+	ListOptions
+}
+
+type listDocumentsResponse struct {
+	Data *[]*Document `json:"data"`
+	Meta ListMeta     `json:"meta"`
+}
+
+// ListDocuments Lists the documents of all contacts
+func (s *DocumentsService) ListDocuments(ctx context.Context, opts *DocumentListOptions) (*[]*Document, *ListMeta, error) {
+	url, err := addOptions("documents", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listDocumentsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListDocumentsForContact Lists the documents of a single contact
+func (s *DocumentsService) ListDocumentsForContact(ctx context.Context, contactId int, opts *DocumentListOptions) (*[]*Document, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/documents", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listDocumentsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetDocument Retrieves the metadata of a single document
+func (s *DocumentsService) GetDocument(ctx context.Context, id int) (*Document, error) {
+	url := fmt.Sprintf("documents/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Document `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UploadDocument Uploads a document and attaches it to a contact. The file
+// must not be larger than the upload limit of the Monica instance.
+func (s *DocumentsService) UploadDocument(ctx context.Context, contactId int, filename string, file io.Reader) (*Document, error) {
+	fields := map[string]string{"contact_id": strconv.Itoa(contactId)}
+	req, err := s.client.NewMultipartRequest("POST", "documents", fields, "document", filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Document `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DownloadDocument Streams the content of a document, the caller must close the
+// returned body
+func (s *DocumentsService) DownloadDocument(ctx context.Context, document *Document) (io.ReadCloser, error) {
+	if document == nil {
+		return nil, ErrEmptyLink
+	}
+	return s.client.Download(ctx, document.Link)
+}
+
+// DeleteDocument Deletes a document
+func (s *DocumentsService) DeleteDocument(ctx context.Context, id int) error {
+	url := fmt.Sprintf("documents/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,9 @@
+package monica
+
+// Emotion is one of the predefined feelings that can be attached to activities
+// and calls
+type Emotion struct {
+	Id     int    `json:"id,omitempty"`
+	Object string `json:"object,omitempty"`
+	Name   string `json:"name"`
+}
@@ -1,8 +1,171 @@
 package monica
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error codes returned by the Monica API in the error_code field of an error
+// response.
+const (
+	ErrorCodeLimitTooBig       = 30
+	ErrorCodeNotFound          = 31
+	ErrorCodeSaveFailed        = 32
+	ErrorCodeTooManyParameters = 33
+	ErrorCodeTooManyAttempts   = 34
+	ErrorCodeEmailTaken        = 35
+	ErrorCodePartialContact    = 36
+	ErrorCodeInvalidJSON       = 37
+	ErrorCodeDateInPast        = 38
+	ErrorCodeInvalidSort       = 39
+	ErrorCodeInvalidQuery      = 40
+	ErrorCodeInvalidParameters = 41
+	ErrorCodeNotAuthorized     = 42
+)
+
 type errorResponse struct {
 	Error struct {
-		Message   string `json:"message"`
-		ErrorCode int    `json:"error_code"`
+		// Message is either a single string or, for validation failures, a
+		// list of strings
+		Message   json.RawMessage `json:"message"`
+		ErrorCode int             `json:"error_code"`
 	} `json:"error"`
 }
+
+// ErrorResponse reports an error caused by an API request.
+type ErrorResponse struct {
+	// Response is the HTTP response that caused this error
+	Response *http.Response
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// ErrorCode is Monica's error_code, 0 if the body did not contain one
+	ErrorCode int
+	// Message is the error message, validation messages joined by "; "
+	Message string
+	// Messages holds the individual validation messages, if any
+	Messages []string
+}
+
+func (r *ErrorResponse) Error() string {
+	prefix := fmt.Sprintf("%d", r.StatusCode)
+	if r.Response != nil && r.Response.Request != nil {
+		prefix = fmt.Sprintf("%v %v: %d", r.Response.Request.Method, r.Response.Request.URL, r.StatusCode)
+	}
+
+	if r.ErrorCode != 0 {
+		return fmt.Sprintf("%s %v (error_code %d)", prefix, r.Message, r.ErrorCode)
+	}
+	return fmt.Sprintf("%s %v", prefix, r.Message)
+}
+
+// RateLimitError occurs when Monica returns 429 Too Many Requests, or when the
+// client knows the rate limit is exhausted and refuses to send a request.
+type RateLimitError struct {
+	// Rate is the rate limit state at the time of the error
+	Rate Rate
+	// Response is the HTTP response that caused this error
+	Response *http.Response
+	// Message is the error message
+	Message string
+}
+
+func (r *RateLimitError) Error() string {
+	prefix := "rate limit exceeded"
+	if r.Response != nil && r.Response.Request != nil {
+		prefix = fmt.Sprintf("%v %v: %d", r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode)
+	}
+
+	if r.Rate.Reset.IsZero() {
+		return fmt.Sprintf("%s %v", prefix, r.Message)
+	}
+	return fmt.Sprintf("%s %v [rate reset in %v]", prefix, r.Message, time.Until(r.Rate.Reset.Time).Round(time.Second))
+}
+
+// AcceptedError occurs when the API answers with 202 Accepted, meaning the
+// request has been queued and the result is not available yet.
+type AcceptedError struct {
+	// Raw contains the response body
+	Raw []byte
+}
+
+func (*AcceptedError) Error() string {
+	return "request accepted, processing is not finished yet"
+}
+
+// newErrorResponse builds an *ErrorResponse from r and its already read body.
+func newErrorResponse(r *http.Response, body []byte) *ErrorResponse {
+	errResp := &ErrorResponse{
+		Response:   r,
+		StatusCode: r.StatusCode,
+	}
+
+	parsed := new(errorResponse)
+	if err := json.Unmarshal(body, parsed); err == nil && len(parsed.Error.Message) > 0 {
+		errResp.ErrorCode = parsed.Error.ErrorCode
+
+		var message string
+		if err := json.Unmarshal(parsed.Error.Message, &message); err == nil {
+			errResp.Message = message
+		} else if err := json.Unmarshal(parsed.Error.Message, &errResp.Messages); err == nil {
+			errResp.Message = strings.Join(errResp.Messages, "; ")
+		}
+	}
+
+	if errResp.Message == "" {
+		errResp.Message = strings.TrimSpace(string(body))
+	}
+	if errResp.Message == "" {
+		errResp.Message = http.StatusText(r.StatusCode)
+	}
+
+	return errResp
+}
+
+// errorCodesOf returns the HTTP status and Monica error code of err if it is
+// an *ErrorResponse.
+func errorCodesOf(err error) (int, int, bool) {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		return 0, 0, false
+	}
+	return errResp.StatusCode, errResp.ErrorCode, true
+}
+
+// IsNotFound reports whether err is an API error for a resource that does not
+// exist.
+func IsNotFound(err error) bool {
+	status, code, ok := errorCodesOf(err)
+	return ok && (status == http.StatusNotFound || code == ErrorCodeNotFound)
+}
+
+// IsRateLimited reports whether err is a *RateLimitError.
+func IsRateLimited(err error) bool {
+	var rateErr *RateLimitError
+	return errors.As(err, &rateErr)
+}
+
+// IsUnauthorized reports whether err is an API error caused by a missing or
+// invalid access token.
+func IsUnauthorized(err error) bool {
+	status, _, ok := errorCodesOf(err)
+	return ok && status == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an API error caused by insufficient
+// permissions.
+func IsForbidden(err error) bool {
+	status, code, ok := errorCodesOf(err)
+	return ok && (status == http.StatusForbidden || code == ErrorCodeNotAuthorized)
+}
+
+// IsValidationError reports whether err is an API error caused by invalid
+// request parameters.
+func IsValidationError(err error) bool {
+	status, code, ok := errorCodesOf(err)
+	return ok && (status == http.StatusUnprocessableEntity ||
+		code == ErrorCodeInvalidParameters || code == ErrorCodeInvalidJSON)
+}
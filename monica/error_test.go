@@ -0,0 +1,128 @@
+package monica
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func newTestResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Scheme: "https", Host: "m.test", Path: "/api/tags"}},
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantCode     int
+		wantMessage  string
+		wantMessages []string
+	}{
+		{
+			name:        "message",
+			status:      http.StatusNotFound,
+			body:        `{"error":{"message":"The resource has not been found","error_code":31}}`,
+			wantCode:    ErrorCodeNotFound,
+			wantMessage: "The resource has not been found",
+		},
+		{
+			name:         "validation messages",
+			status:       http.StatusUnprocessableEntity,
+			body:         `{"error":{"message":["The name field is required.","The id is invalid."],"error_code":41}}`,
+			wantCode:     ErrorCodeInvalidParameters,
+			wantMessage:  "The name field is required.; The id is invalid.",
+			wantMessages: []string{"The name field is required.", "The id is invalid."},
+		},
+		{
+			name:        "no json",
+			status:      http.StatusBadGateway,
+			body:        "<html>bad gateway</html>",
+			wantMessage: "<html>bad gateway</html>",
+		},
+		{
+			name:        "empty body",
+			status:      http.StatusInternalServerError,
+			wantMessage: "Internal Server Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckResponse(newTestResponse(tt.status, tt.body))
+
+			var errResp *ErrorResponse
+			if !errors.As(err, &errResp) {
+				t.Fatalf("CheckResponse() = %v, want *ErrorResponse", err)
+			}
+			if errResp.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", errResp.StatusCode, tt.status)
+			}
+			if errResp.ErrorCode != tt.wantCode {
+				t.Errorf("ErrorCode = %d, want %d", errResp.ErrorCode, tt.wantCode)
+			}
+			if errResp.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", errResp.Message, tt.wantMessage)
+			}
+			if !reflect.DeepEqual(errResp.Messages, tt.wantMessages) {
+				t.Errorf("Messages = %q, want %q", errResp.Messages, tt.wantMessages)
+			}
+		})
+	}
+}
+
+func TestCheckResponse_special(t *testing.T) {
+	if err := CheckResponse(newTestResponse(http.StatusOK, "{}")); err != nil {
+		t.Errorf("200: unexpected error %v", err)
+	}
+
+	var accepted *AcceptedError
+	if err := CheckResponse(newTestResponse(http.StatusAccepted, "queued")); !errors.As(err, &accepted) || string(accepted.Raw) != "queued" {
+		t.Errorf("202: got %v, want *AcceptedError with body", err)
+	}
+
+	var rateErr *RateLimitError
+	if err := CheckResponse(newTestResponse(http.StatusTooManyRequests, "")); !errors.As(err, &rateErr) {
+		t.Errorf("429: got %v, want *RateLimitError", err)
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	notFound := CheckResponse(newTestResponse(http.StatusNotFound, ""))
+	invalid := CheckResponse(newTestResponse(http.StatusUnprocessableEntity, ""))
+	unauthorized := CheckResponse(newTestResponse(http.StatusUnauthorized, ""))
+	rateLimited := CheckResponse(newTestResponse(http.StatusTooManyRequests, ""))
+	wrapped := fmt.Errorf("fetching tag: %w", notFound)
+
+	tests := []struct {
+		name string
+		fn   func(error) bool
+		err  error
+		want bool
+	}{
+		{"IsNotFound", IsNotFound, notFound, true},
+		{"IsNotFound wrapped", IsNotFound, wrapped, true},
+		{"IsNotFound other", IsNotFound, invalid, false},
+		{"IsNotFound plain error", IsNotFound, errors.New("x"), false},
+		{"IsValidationError", IsValidationError, invalid, true},
+		{"IsUnauthorized", IsUnauthorized, unauthorized, true},
+		{"IsRateLimited", IsRateLimited, rateLimited, true},
+		{"IsRateLimited other", IsRateLimited, notFound, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(tt.err); got != tt.want {
+			t.Errorf("%s(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
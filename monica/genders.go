@@ -24,7 +24,7 @@ type GenderListOptions struct {
 
 type listGenderResponse struct {
 	Data *[]*Gender ` json:"data"`
-	Meta ListMeta `json:"meta"`
+	Meta ListMeta   `json:"meta"`
 }
 
 func (s *GenderService) ListGenders(ctx context.Context, opts *GenderListOptions) (*[]*Gender, *ListMeta, error) {
@@ -45,4 +45,29 @@ func (s *GenderService) ListGenders(ctx context.Context, opts *GenderListOptions
 	}
 
 	return response.Data, &response.Meta, nil
-}
\ No newline at end of file
+}
+
+// ListAllGenders walks all pages of genders starting at opts.Page and calls fn
+// for every gender. Iteration stops at the first error returned by fn.
+func (s *GenderService) ListAllGenders(ctx context.Context, opts *GenderListOptions, fn func(*Gender) error) error {
+	o := GenderListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var genders *[]*Gender
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		genders, meta, err = s.ListGenders(ctx, &o)
+		return meta, err
+	}, func() error {
+		if genders == nil {
+			return nil
+		}
+		for _, gender := range *genders {
+			if err := fn(gender); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,208 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// GiftsService handles communication with the gift related methods of the API.
+// API docs: https://www.monicahq.com/api/gifts
+type GiftsService service
+
+type GiftStatus string
+
+const (
+	GiftIdea     GiftStatus = "idea"
+	GiftOffered  GiftStatus = "offered"
+	GiftReceived GiftStatus = "received"
+)
+
+type Gift struct {
+	Id      int    `json:"id,omitempty"`
+	Object  string `json:"object,omitempty"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+	Url     string `json:"url"`
+	Amount  Amount `json:"amount"`
+	// Currency is nil if the account has no currency set
+	Currency *Currency `json:"currency"`
+	// AmountWithCurrency is the amount formatted in the account's currency
+	AmountWithCurrency string     `json:"amount_with_currency"`
+	Status             GiftStatus `json:"status"`
+	// IsAnIdea, HasBeenOffered and HasBeenReceived mirror Status
+	IsAnIdea        bool `json:"is_an_idea"`
+	HasBeenOffered  bool `json:"has_been_offered"`
+	HasBeenReceived bool `json:"has_been_received"`
+	Date            Date `json:"date"`
+	// Recipient is the contact the gift is for, if it is not Contact itself
+	Recipient *Contact `json:"recipient"`
+	Account   struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// Money returns the amount of the gift along with its currency
+func (g *Gift) Money() Money {
+	return Money{Amount: g.Amount, Currency: g.Currency}
+}
+
+type GiftInput struct {
+	ContactId int `json:"contact_id"`
+	// RecipientId optionally names a relative of the contact as recipient
+	RecipientId int `json:"recipient_id,omitempty"`
+	// Name of the gift, max 255 characters
+	Name    string     `json:"name"`
+	Comment string     `json:"comment,omitempty"`
+	Url     string     `json:"url,omitempty"`
+	Amount  float64    `json:"amount,omitempty"`
+	Status  GiftStatus `json:"status"`
+	Date    Date       `json:"date"`
+}
+
+type GiftListOptions struct {
+	ListOptions
+}
+
+type listGiftsResponse struct {
+	Data *[]*Gift `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// ListGifts Lists the gifts of all contacts
+func (s *GiftsService) ListGifts(ctx context.Context, opts *GiftListOptions) (*[]*Gift, *ListMeta, error) {
+	url, err := addOptions("gifts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listGiftsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListGiftsForContact Lists the gifts of a single contact
+func (s *GiftsService) ListGiftsForContact(ctx context.Context, contactId int, opts *GiftListOptions) (*[]*Gift, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/gifts", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listGiftsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetGift Retrieves a single gift
+func (s *GiftsService) GetGift(ctx context.Context, id int) (*Gift, error) {
+	url := fmt.Sprintf("gifts/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Gift `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateGift Creates a gift, or a gift idea, for a contact
+func (s *GiftsService) CreateGift(ctx context.Context, input *GiftInput) (*Gift, error) {
+	req, err := s.client.NewRequest("POST", "gifts", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Gift `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateGift Updates a gift
+func (s *GiftsService) UpdateGift(ctx context.Context, id int, input *GiftInput) (*Gift, error) {
+	url := fmt.Sprintf("gifts/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Gift `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteGift Deletes a gift
+func (s *GiftsService) DeleteGift(ctx context.Context, id int) error {
+	url := fmt.Sprintf("gifts/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllGifts walks all pages of gifts starting at opts.Page and calls fn for
+// every gift. Iteration stops at the first error returned by fn.
+func (s *GiftsService) ListAllGifts(ctx context.Context, opts *GiftListOptions, fn func(*Gift) error) error {
+	o := GiftListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var gifts *[]*Gift
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		gifts, meta, err = s.ListGifts(ctx, &o)
+		return meta, err
+	}, func() error {
+		if gifts == nil {
+			return nil
+		}
+		for _, gift := range *gifts {
+			if err := fn(gift); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
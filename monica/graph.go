@@ -0,0 +1,202 @@
+package monica
+
+import (
+	"context"
+	"sort"
+)
+
+// RelationshipGraph is an in-memory graph of contacts connected by their
+// relationships. Edges are traversable in both directions.
+type RelationshipGraph struct {
+	contacts map[int]*Contact
+	edges    map[int][]*RelationshipEdge
+	// groups maps relationship type group ids to their names
+	groups map[int]string
+}
+
+// RelationshipEdge connects two contacts of a RelationshipGraph
+type RelationshipEdge struct {
+	From int
+	To   int
+	// Type is the relationship type as stored by Monica. For reverse edges it
+	// describes To's role towards From.
+	Type RelationshipType
+	// Group is the name of the type's group, like RelationshipGroupFamily
+	Group string
+	// Reverse is set for edges going from OfContact to ContactIs
+	Reverse bool
+}
+
+// NewRelationshipGraph creates an empty graph. groups is used to resolve the
+// group names of relationship types.
+func NewRelationshipGraph(groups []*RelationshipTypeGroup) *RelationshipGraph {
+	g := &RelationshipGraph{
+		contacts: make(map[int]*Contact),
+		edges:    make(map[int][]*RelationshipEdge),
+		groups:   make(map[int]string),
+	}
+	for _, group := range groups {
+		g.groups[group.Id] = group.Name
+	}
+	return g
+}
+
+// AddRelationship adds r to the graph. Adding the same relationship twice
+// has no effect.
+func (g *RelationshipGraph) AddRelationship(r *Relationship) {
+	from, to := r.ContactIs, r.OfContact
+	g.addContact(from)
+	g.addContact(to)
+
+	group := g.groups[r.RelationshipType.RelationshipTypeGroupId]
+	g.addEdge(&RelationshipEdge{From: from.Id, To: to.Id, Type: r.RelationshipType, Group: group})
+	g.addEdge(&RelationshipEdge{From: to.Id, To: from.Id, Type: r.RelationshipType, Group: group, Reverse: true})
+}
+
+func (g *RelationshipGraph) addContact(contact Contact) {
+	if _, ok := g.contacts[contact.Id]; !ok {
+		c := contact
+		g.contacts[contact.Id] = &c
+	}
+}
+
+func (g *RelationshipGraph) addEdge(edge *RelationshipEdge) {
+	for _, e := range g.edges[edge.From] {
+		if e.To == edge.To && e.Type.Id == edge.Type.Id && e.Reverse == edge.Reverse {
+			return
+		}
+	}
+	g.edges[edge.From] = append(g.edges[edge.From], edge)
+}
+
+// Contact returns the contact with given id, as embedded in the relationships
+// of the graph, or nil if it is not part of the graph.
+func (g *RelationshipGraph) Contact(id int) *Contact {
+	return g.contacts[id]
+}
+
+// Edges returns the relationships of a single contact.
+func (g *RelationshipGraph) Edges(contactId int) []*RelationshipEdge {
+	return g.edges[contactId]
+}
+
+// Related returns the ids of all contacts transitively connected to contactId
+// through relationships of the given groups, or of any group if none are given.
+// The result is sorted and does not contain contactId itself.
+func (g *RelationshipGraph) Related(contactId int, groups ...string) []int {
+	allowed := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		allowed[group] = true
+	}
+
+	seen := map[int]bool{contactId: true}
+	queue := []int{contactId}
+	var related []int
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range g.edges[current] {
+			if len(allowed) > 0 && !allowed[edge.Group] {
+				continue
+			}
+			if seen[edge.To] {
+				continue
+			}
+			seen[edge.To] = true
+			related = append(related, edge.To)
+			queue = append(queue, edge.To)
+		}
+	}
+
+	sort.Ints(related)
+	return related
+}
+
+// Relatives returns the ids of all contacts in the family of contactId, see
+// Related.
+func (g *RelationshipGraph) Relatives(contactId int) []int {
+	return g.Related(contactId, RelationshipGroupFamily)
+}
+
+// ShortestPath returns the contact ids on a shortest path from one contact to
+// another, including both ends, or nil if they are not connected.
+func (g *RelationshipGraph) ShortestPath(from, to int) []int {
+	if _, ok := g.contacts[from]; !ok {
+		return nil
+	}
+	if from == to {
+		return []int{from}
+	}
+
+	previous := map[int]int{from: from}
+	queue := []int{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range g.edges[current] {
+			if _, ok := previous[edge.To]; ok {
+				continue
+			}
+			previous[edge.To] = current
+			if edge.To == to {
+				return buildPath(previous, from, to)
+			}
+			queue = append(queue, edge.To)
+		}
+	}
+
+	return nil
+}
+
+// buildPath walks previous back from to and returns the path in order.
+func buildPath(previous map[int]int, from, to int) []int {
+	path := []int{to}
+	for current := to; current != from; {
+		current = previous[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// BuildRelationshipGraph fetches the relationships of the given contacts and
+// builds a graph from them. Related contacts not in contactIds are part of the
+// graph, but their own relationships are not fetched.
+func (s *RelationshipsService) BuildRelationshipGraph(ctx context.Context, contactIds []int) (*RelationshipGraph, error) {
+	var groups []*RelationshipTypeGroup
+	err := (*RelationshipTypeGroupsService)(s).ListAllRelationshipTypeGroups(ctx, nil, func(group *RelationshipTypeGroup) error {
+		groups = append(groups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	graph := NewRelationshipGraph(groups)
+	for _, contactId := range contactIds {
+		opts := RelationshipListOptions{}
+		var relationships *[]*Relationship
+		err := paginate(ctx, &opts.ListOptions, func() (meta *ListMeta, err error) {
+			relationships, meta, err = s.ListRelationshipsForContact(ctx, contactId, &opts)
+			return meta, err
+		}, func() error {
+			if relationships == nil {
+				return nil
+			}
+			for _, relationship := range *relationships {
+				graph.AddRelationship(relationship)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,136 @@
+package monica
+
+import (
+	"reflect"
+	"testing"
+)
+
+// newTestGraph builds a small graph of two families, friends and colleagues:
+//
+//	Carol (3) is the sibling of Alice (1), who is the parent of Bob (2)
+//	Bob (2) is a friend of Dave (4), Eve (5) is a colleague of Dave (4)
+//	Frank (6) is a friend of Grace (7), unconnected to the others
+func newTestGraph() *RelationshipGraph {
+	groups := []*RelationshipTypeGroup{
+		{Id: 1, Name: RelationshipGroupFamily},
+		{Id: 2, Name: RelationshipGroupFriend},
+		{Id: 3, Name: RelationshipGroupWork},
+	}
+	parent := RelationshipType{Id: 1, Name: "parent", NameReverseRelationship: "child", RelationshipTypeGroupId: 1}
+	sibling := RelationshipType{Id: 2, Name: "sibling", NameReverseRelationship: "sibling", RelationshipTypeGroupId: 1}
+	friend := RelationshipType{Id: 3, Name: "friend", NameReverseRelationship: "friend", RelationshipTypeGroupId: 2}
+	colleague := RelationshipType{Id: 4, Name: "colleague", NameReverseRelationship: "colleague", RelationshipTypeGroupId: 3}
+
+	relationships := []*Relationship{
+		{Id: 1, ContactIs: Contact{Id: 1, FirstName: "Alice"}, RelationshipType: parent, OfContact: Contact{Id: 2, FirstName: "Bob"}},
+		{Id: 2, ContactIs: Contact{Id: 3, FirstName: "Carol"}, RelationshipType: sibling, OfContact: Contact{Id: 1, FirstName: "Alice"}},
+		{Id: 3, ContactIs: Contact{Id: 2, FirstName: "Bob"}, RelationshipType: friend, OfContact: Contact{Id: 4, FirstName: "Dave"}},
+		{Id: 4, ContactIs: Contact{Id: 5, FirstName: "Eve"}, RelationshipType: colleague, OfContact: Contact{Id: 4, FirstName: "Dave"}},
+		{Id: 5, ContactIs: Contact{Id: 6, FirstName: "Frank"}, RelationshipType: friend, OfContact: Contact{Id: 7, FirstName: "Grace"}},
+	}
+
+	g := NewRelationshipGraph(groups)
+	for _, r := range relationships {
+		g.AddRelationship(r)
+	}
+	return g
+}
+
+func TestRelationshipGraph_Related(t *testing.T) {
+	g := newTestGraph()
+
+	tests := []struct {
+		name    string
+		contact int
+		groups  []string
+		want    []int
+	}{
+		{name: "family through reverse edges only", contact: 2, groups: []string{RelationshipGroupFamily}, want: []int{1, 3}},
+		{name: "family through forward edges", contact: 3, groups: []string{RelationshipGroupFamily}, want: []int{1, 2}},
+		{name: "all groups", contact: 2, want: []int{1, 3, 4, 5}},
+		{name: "friends only", contact: 2, groups: []string{RelationshipGroupFriend}, want: []int{4}},
+		{name: "friends and work", contact: 4, groups: []string{RelationshipGroupFriend, RelationshipGroupWork}, want: []int{2, 5}},
+		{name: "group without relationships", contact: 2, groups: []string{RelationshipGroupLove}},
+		{name: "disconnected component", contact: 7, want: []int{6}},
+		{name: "unknown contact", contact: 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.Related(tt.contact, tt.groups...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Related(%d, %q) = %v, want %v", tt.contact, tt.groups, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := g.Relatives(2), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Relatives(2) = %v, want %v", got, want)
+	}
+}
+
+func TestRelationshipGraph_ShortestPath(t *testing.T) {
+	g := newTestGraph()
+
+	tests := []struct {
+		name     string
+		from, to int
+		want     []int
+	}{
+		{name: "direct forward edge", from: 1, to: 2, want: []int{1, 2}},
+		{name: "direct reverse edge", from: 2, to: 1, want: []int{2, 1}},
+		{name: "across groups", from: 3, to: 5, want: []int{3, 1, 2, 4, 5}},
+		{name: "back across groups", from: 5, to: 3, want: []int{5, 4, 2, 1, 3}},
+		{name: "same contact", from: 4, to: 4, want: []int{4}},
+		{name: "disconnected", from: 1, to: 6},
+		{name: "unknown from", from: 99, to: 1},
+		{name: "unknown from is to", from: 99, to: 99},
+		{name: "unknown to", from: 1, to: 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.ShortestPath(tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ShortestPath(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelationshipGraph_AddRelationship(t *testing.T) {
+	g := newTestGraph()
+
+	// adding a relationship again does not duplicate its edges
+	g.AddRelationship(&Relationship{
+		ContactIs:        Contact{Id: 1},
+		RelationshipType: RelationshipType{Id: 1, Name: "parent", RelationshipTypeGroupId: 1},
+		OfContact:        Contact{Id: 2},
+	})
+
+	edges := g.Edges(2)
+	if len(edges) != 2 {
+		t.Fatalf("Edges(2) has %d edges, want 2", len(edges))
+	}
+
+	// Alice is the parent of Bob, so Bob's edge to Alice is the reverse one
+	toAlice := edges[0]
+	if toAlice.To != 1 || !toAlice.Reverse || toAlice.Type.Name != "parent" || toAlice.Group != RelationshipGroupFamily {
+		t.Errorf("Edges(2)[0] = %+v, want a reverse family edge to 1 of type parent", toAlice)
+	}
+
+	// Bob is the friend of Dave, so Bob's edge to Dave is a forward one
+	toDave := edges[1]
+	if toDave.To != 4 || toDave.Reverse || toDave.Group != RelationshipGroupFriend {
+		t.Errorf("Edges(2)[1] = %+v, want a forward friend edge to 4", toDave)
+	}
+
+	if forward := g.Edges(1); len(forward) != 2 || forward[0].To != 2 || forward[0].Reverse {
+		t.Errorf("Edges(1) = %+v, want a forward edge to 2 first", forward)
+	}
+
+	if c := g.Contact(1); c == nil || c.FirstName != "Alice" {
+		t.Errorf("Contact(1) = %+v, want Alice", c)
+	}
+	if c := g.Contact(99); c != nil {
+		t.Errorf("Contact(99) = %+v, want nil", c)
+	}
+}
@@ -0,0 +1,245 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// GroupsService handles communication with the group related methods of the API.
+// API docs: https://www.monicahq.com/api/groups
+type GroupsService service
+
+type Group struct {
+	Id       int       `json:"id,omitempty"`
+	Object   string    `json:"object,omitempty"`
+	Name     string    `json:"name"`
+	Contacts []Contact `json:"contacts"`
+	Account  struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type GroupInput struct {
+	// Name of the group, max 255 characters
+	Name string `json:"name"`
+}
+
+type groupMembersInput struct {
+	Contacts []int `json:"contacts"`
+}
+
+type GroupListOptions struct {
+	ListOptions
+}
+
+type listGroupsResponse struct {
+	Data *[]*Group `json:"data"`
+	Meta ListMeta  `json:"meta"`
+}
+
+// ListGroups Lists all groups
+func (s *GroupsService) ListGroups(ctx context.Context, opts *GroupListOptions) (*[]*Group, *ListMeta, error) {
+	url, err := addOptions("groups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listGroupsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetGroup Retrieves a single group with its contacts
+func (s *GroupsService) GetGroup(ctx context.Context, id int) (*Group, error) {
+	url := fmt.Sprintf("groups/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Group `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateGroup Creates an empty group
+func (s *GroupsService) CreateGroup(ctx context.Context, input *GroupInput) (*Group, error) {
+	req, err := s.client.NewRequest("POST", "groups", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Group `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateGroup Renames a group
+func (s *GroupsService) UpdateGroup(ctx context.Context, id int, input *GroupInput) (*Group, error) {
+	url := fmt.Sprintf("groups/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Group `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteGroup Deletes a group, its contacts are kept
+func (s *GroupsService) DeleteGroup(ctx context.Context, id int) error {
+	url := fmt.Sprintf("groups/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// AttachContacts Adds contacts to a group, returns the updated group
+func (s *GroupsService) AttachContacts(ctx context.Context, groupId int, contactIds []int) (*Group, error) {
+	url := fmt.Sprintf("groups/%d/attach", groupId)
+	body := groupMembersInput{Contacts: contactIds}
+	req, err := s.client.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Group `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DetachContacts Removes contacts from a group, returns the updated
+// group
+func (s *GroupsService) DetachContacts(ctx context.Context, groupId int, contactIds []int) (*Group, error) {
+	url := fmt.Sprintf("groups/%d/detach", groupId)
+	body := groupMembersInput{Contacts: contactIds}
+	req, err := s.client.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Group `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// SyncGroupContacts Makes contactIds the exact member list of a group by
+// attaching missing and detaching superfluous contacts
+func (s *GroupsService) SyncGroupContacts(ctx context.Context, groupId int, contactIds []int) (*Group, error) {
+	group, err := s.GetGroup(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, ErrEmptyResponse
+	}
+
+	wanted := make(map[int]bool, len(contactIds))
+	for _, id := range contactIds {
+		wanted[id] = true
+	}
+
+	current := make(map[int]bool, len(group.Contacts))
+	var detach []int
+	for _, contact := range group.Contacts {
+		current[contact.Id] = true
+		if !wanted[contact.Id] {
+			detach = append(detach, contact.Id)
+		}
+	}
+
+	var attach []int
+	for _, id := range contactIds {
+		if !current[id] {
+			attach = append(attach, id)
+			current[id] = true
+		}
+	}
+
+	if len(attach) > 0 {
+		group, err = s.AttachContacts(ctx, groupId, attach)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(detach) > 0 {
+		group, err = s.DetachContacts(ctx, groupId, detach)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return group, nil
+}
+
+// ListAllGroups walks all pages of groups starting at opts.Page and calls fn
+// for every group. Iteration stops at the first error returned by fn.
+func (s *GroupsService) ListAllGroups(ctx context.Context, opts *GroupListOptions, fn func(*Group) error) error {
+	o := GroupListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var groups *[]*Group
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		groups, meta, err = s.ListGroups(ctx, &o)
+		return meta, err
+	}, func() error {
+		if groups == nil {
+			return nil
+		}
+		for _, group := range *groups {
+			if err := fn(group); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
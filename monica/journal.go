@@ -0,0 +1,154 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// JournalService handles communication with the journal related methods of the API.
+// API docs: https://www.monicahq.com/api/journal
+type JournalService service
+
+type JournalEntry struct {
+	Id      int    `json:"id,omitempty"`
+	Object  string `json:"object,omitempty"`
+	Title   string `json:"title"`
+	Post    string `json:"post"`
+	Account struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type JournalEntryInput struct {
+	// Title of the entry, max 250 characters
+	Title string `json:"title"`
+	// Post is the content of the entry, max 1000000 characters
+	Post string `json:"post"`
+}
+
+type JournalListOptions struct {
+	ListOptions
+}
+
+type listJournalEntriesResponse struct {
+	Data *[]*JournalEntry `json:"data"`
+	Meta ListMeta         `json:"meta"`
+}
+
+// ListJournalEntries Lists all journal entries
+func (s *JournalService) ListJournalEntries(ctx context.Context, opts *JournalListOptions) (*[]*JournalEntry, *ListMeta, error) {
+	url, err := addOptions("journal", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listJournalEntriesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetJournalEntry Retrieves a single journal entry
+func (s *JournalService) GetJournalEntry(ctx context.Context, id int) (*JournalEntry, error) {
+	url := fmt.Sprintf("journal/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *JournalEntry `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateJournalEntry Writes a journal entry
+func (s *JournalService) CreateJournalEntry(ctx context.Context, input *JournalEntryInput) (*JournalEntry, error) {
+	req, err := s.client.NewRequest("POST", "journal", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *JournalEntry `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateJournalEntry Updates a journal entry
+func (s *JournalService) UpdateJournalEntry(ctx context.Context, id int, input *JournalEntryInput) (*JournalEntry, error) {
+	url := fmt.Sprintf("journal/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *JournalEntry `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteJournalEntry Deletes a journal entry
+func (s *JournalService) DeleteJournalEntry(ctx context.Context, id int) error {
+	url := fmt.Sprintf("journal/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllJournalEntries walks all pages of journal entries starting at
+// opts.Page and calls fn for every entry. Iteration stops at the first error
+// returned by fn.
+func (s *JournalService) ListAllJournalEntries(ctx context.Context, opts *JournalListOptions, fn func(*JournalEntry) error) error {
+	o := JournalListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var entries *[]*JournalEntry
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		entries, meta, err = s.ListJournalEntries(ctx, &o)
+		return meta, err
+	}, func() error {
+		if entries == nil {
+			return nil
+		}
+		for _, entry := range *entries {
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
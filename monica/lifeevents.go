@@ -0,0 +1,302 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// LifeEventsService handles communication with the life event related methods of the API.
+// API docs: https://www.monicahq.com/api/lifeevents
+type LifeEventsService service
+
+// LifeEventTypesService handles communication with the life event type related
+// methods of the API.
+type LifeEventTypesService service
+
+// LifeEventCategoriesService handles communication with the life event
+// category related methods of the API.
+type LifeEventCategoriesService service
+
+type LifeEvent struct {
+	Id         int    `json:"id,omitempty"`
+	Object     string `json:"object,omitempty"`
+	Name       string `json:"name"`
+	Note       string `json:"note"`
+	HappenedAt Date   `json:"happened_at"`
+	// HappenedAtMonthUnknown and HappenedAtDayUnknown mark the parts of
+	// HappenedAt that are placeholders
+	HappenedAtMonthUnknown bool          `json:"happened_at_month_unknown"`
+	HappenedAtDayUnknown   bool          `json:"happened_at_day_unknown"`
+	LifeEventType          LifeEventType `json:"life_event_type"`
+	Account                struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type LifeEventInput struct {
+	ContactId       int  `json:"contact_id"`
+	LifeEventTypeId int  `json:"life_event_type_id"`
+	HappenedAt      Date `json:"happened_at"`
+	// Name of the event, max 255 characters
+	Name string `json:"name,omitempty"`
+	// Note about the event, max 1000000 characters
+	Note string `json:"note,omitempty"`
+	// HasReminder creates a yearly reminder for the event
+	HasReminder            bool `json:"has_reminder"`
+	HappenedAtMonthUnknown bool `json:"happened_at_month_unknown"`
+	HappenedAtDayUnknown   bool `json:"happened_at_day_unknown"`
+}
+
+type LifeEventType struct {
+	Id     int    `json:"id,omitempty"`
+	Object string `json:"object,omitempty"`
+	Name   string `json:"name"`
+	// CoreMonicaData marks the types shipped with Monica
+	CoreMonicaData    bool              `json:"core_monica_data"`
+	LifeEventCategory LifeEventCategory `json:"life_event_category"`
+	Account           struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type LifeEventCategory struct {
+	Id     int    `json:"id,omitempty"`
+	Object string `json:"object,omitempty"`
+	Name   string `json:"name"`
+	// CoreMonicaData marks the categories shipped with Monica
+	CoreMonicaData bool `json:"core_monica_data"`
+	Account        struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type LifeEventListOptions struct {
+	ListOptions
+}
+
+type LifeEventTypeListOptions struct {
+	ListOptions
+}
+
+type LifeEventCategoryListOptions struct {
+	ListOptions
+}
+
+type listLifeEventsResponse struct {
+	Data *[]*LifeEvent `json:"data"`
+	Meta ListMeta      `json:"meta"`
+}
+
+type listLifeEventTypesResponse struct {
+	Data *[]*LifeEventType `json:"data"`
+	Meta ListMeta          `json:"meta"`
+}
+
+type listLifeEventCategoriesResponse struct {
+	Data *[]*LifeEventCategory `json:"data"`
+	Meta ListMeta              `json:"meta"`
+}
+
+// ListLifeEvents Lists the life events of all contacts
+func (s *LifeEventsService) ListLifeEvents(ctx context.Context, opts *LifeEventListOptions) (*[]*LifeEvent, *ListMeta, error) {
+	url, err := addOptions("lifeevents", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listLifeEventsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetLifeEvent Retrieves a single life event
+func (s *LifeEventsService) GetLifeEvent(ctx context.Context, id int) (*LifeEvent, error) {
+	url := fmt.Sprintf("lifeevents/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *LifeEvent `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateLifeEvent Adds a life event to a contact
+func (s *LifeEventsService) CreateLifeEvent(ctx context.Context, input *LifeEventInput) (*LifeEvent, error) {
+	req, err := s.client.NewRequest("POST", "lifeevents", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *LifeEvent `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateLifeEvent Updates a life event
+func (s *LifeEventsService) UpdateLifeEvent(ctx context.Context, id int, input *LifeEventInput) (*LifeEvent, error) {
+	url := fmt.Sprintf("lifeevents/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *LifeEvent `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteLifeEvent Deletes a life event
+func (s *LifeEventsService) DeleteLifeEvent(ctx context.Context, id int) error {
+	url := fmt.Sprintf("lifeevents/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllLifeEvents walks all pages of life events starting at opts.Page and
+// calls fn for every event. Iteration stops at the first error returned by fn.
+func (s *LifeEventsService) ListAllLifeEvents(ctx context.Context, opts *LifeEventListOptions, fn func(*LifeEvent) error) error {
+	o := LifeEventListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var events *[]*LifeEvent
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		events, meta, err = s.ListLifeEvents(ctx, &o)
+		return meta, err
+	}, func() error {
+		if events == nil {
+			return nil
+		}
+		for _, event := range *events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListLifeEventTypes Lists all life event types
+func (s *LifeEventTypesService) ListLifeEventTypes(ctx context.Context, opts *LifeEventTypeListOptions) (*[]*LifeEventType, *ListMeta, error) {
+	url, err := addOptions("lifeeventtypes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listLifeEventTypesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetLifeEventType Retrieves a single life event type
+func (s *LifeEventTypesService) GetLifeEventType(ctx context.Context, id int) (*LifeEventType, error) {
+	url := fmt.Sprintf("lifeeventtypes/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *LifeEventType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListLifeEventCategories Lists all life event categories
+func (s *LifeEventCategoriesService) ListLifeEventCategories(ctx context.Context, opts *LifeEventCategoryListOptions) (*[]*LifeEventCategory, *ListMeta, error) {
+	url, err := addOptions("lifeeventcategories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listLifeEventCategoriesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetLifeEventCategory Retrieves a single life event category
+func (s *LifeEventCategoriesService) GetLifeEventCategory(ctx context.Context, id int) (*LifeEventCategory, error) {
+	url := fmt.Sprintf("lifeeventcategories/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *LifeEventCategory `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
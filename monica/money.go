@@ -0,0 +1,200 @@
+package monica
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownCurrency is returned by ParseMoney for unrecognized currencies
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// moneyNumber matches the numeric part of a formatted amount, including
+// amounts without integer part like ".5"
+var moneyNumber = regexp.MustCompile(`[-+]?(?:[0-9]|[.,][0-9])[0-9.,' ]*`)
+
+// thousandsGroups matches an integer part grouped by a thousands separator,
+// which is replaced by the actual separator before use. A leading group of
+// "0" is never grouped.
+var thousandsGroups = `^[1-9][0-9]{0,2}(?:S[0-9]{3})*$`
+
+// Amount is a monetary amount. Monica returns amounts as JSON numbers or as
+// strings depending on the endpoint, Amount accepts both.
+type Amount float64
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Amounts are expected as number, numeric string or null.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), `"`)
+	if str == "null" || str == "" {
+		*a = 0
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return fmt.Errorf("invalid amount %s: %w", data, err)
+	}
+
+	*a = Amount(v)
+	return nil
+}
+
+// Money is an amount in a currency
+type Money struct {
+	Amount Amount
+	// Currency is nil if the currency is unknown
+	Currency *Currency
+}
+
+// String formats m with two decimals, prefixed by the currency symbol if there
+// is one, suffixed by the ISO code otherwise, e.g. "$12.50" or "12.50 CHF".
+func (m Money) String() string {
+	amount := strconv.FormatFloat(float64(m.Amount), 'f', 2, 64)
+	if strings.Trim(amount, "-0.") == "" {
+		// amounts rounding to zero are formatted without sign
+		amount = "0.00"
+	}
+	switch {
+	case m.Currency == nil:
+		return amount
+	case m.Currency.Symbol != "":
+		if strings.HasPrefix(amount, "-") {
+			return "-" + m.Currency.Symbol + amount[1:]
+		}
+		return m.Currency.Symbol + amount
+	default:
+		return amount + " " + m.Currency.Iso
+	}
+}
+
+// ParseMoney parses amounts like "12.50 EUR", "EUR 12.50", "-3 usd" or
+// ".5 EUR". Currencies are resolved by ISO code or symbol from currencies, so
+// "$1,200" or "1.200,50 €" need currencies containing the symbols "$" and "€".
+// If currencies is empty, only three letter codes are accepted, as ISO codes.
+// Strings without a currency result in a Money with nil Currency.
+func ParseMoney(s string, currencies []*Currency) (Money, error) {
+	s = strings.TrimSpace(s)
+	loc := moneyNumber.FindStringIndex(s)
+	if loc == nil {
+		return Money{}, fmt.Errorf("no amount in %q", s)
+	}
+
+	amount, err := parseAmount(s[loc[0]:loc[1]])
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount in %q: %w", s, err)
+	}
+
+	token := strings.TrimSpace(s[:loc[0]] + s[loc[1]:])
+	negative := strings.HasPrefix(token, "-")
+	token = strings.TrimSpace(strings.TrimPrefix(token, "-"))
+	if negative {
+		amount = -amount
+	}
+
+	money := Money{Amount: Amount(amount)}
+	if token == "" {
+		return money, nil
+	}
+
+	for _, currency := range currencies {
+		if strings.EqualFold(currency.Iso, token) || (currency.Symbol != "" && currency.Symbol == token) {
+			money.Currency = currency
+			return money, nil
+		}
+	}
+
+	if len(currencies) == 0 && len(token) == 3 && isLetters(token) {
+		money.Currency = &Currency{Iso: strings.ToUpper(token)}
+		return money, nil
+	}
+
+	return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, token)
+}
+
+// parseAmount parses a number with optional thousands separators. If both
+// "." and "," occur, the last one is the decimal separator. If only one of them
+// occurs, it is a thousands separator when it occurs several times or is
+// followed by exactly three digits, and the decimal separator otherwise, or if
+// the integer part starts with "0" like in "0.500". Thousands separators must
+// group the integer part by three digits.
+func parseAmount(s string) (float64, error) {
+	s = strings.NewReplacer(" ", "", "'", "").Replace(strings.TrimSpace(s))
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	dots := strings.Count(s, ".")
+	commas := strings.Count(s, ",")
+
+	var integer, fraction, thousands string
+	switch {
+	case dots == 0 && commas == 0:
+		integer = s
+	case dots > 0 && commas > 0:
+		decimal := "."
+		thousands = ","
+		if strings.LastIndex(s, ",") > strings.LastIndex(s, ".") {
+			decimal, thousands = ",", "."
+		}
+		if strings.Count(s, decimal) > 1 {
+			return 0, fmt.Errorf("more than one decimal separator in %q", s)
+		}
+		i := strings.LastIndex(s, decimal)
+		integer, fraction = s[:i], s[i+1:]
+	default:
+		separator := "."
+		if commas > 0 {
+			separator = ","
+		}
+		i := strings.LastIndex(s, separator)
+		if strings.Count(s, separator) > 1 || (len(s)-i-1 == 3 && i > 0 && s[0] != '0') {
+			integer, thousands = s, separator
+		} else {
+			integer, fraction = s[:i], s[i+1:]
+		}
+	}
+
+	if thousands != "" {
+		groups := strings.Replace(thousandsGroups, "S", regexp.QuoteMeta(thousands), 1)
+		if !regexp.MustCompile(groups).MatchString(integer) {
+			return 0, fmt.Errorf("misplaced thousands separator in %q", s)
+		}
+		integer = strings.ReplaceAll(integer, thousands, "")
+	}
+
+	number := integer
+	if number == "" {
+		number = "0"
+	}
+	if fraction != "" {
+		number += "." + fraction
+	}
+	if !isDigits(integer) || !isDigits(fraction) || integer+fraction == "" {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	return strconv.ParseFloat(sign+number, 64)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
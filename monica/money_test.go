@@ -0,0 +1,169 @@
+package monica
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{in: "12", want: 12},
+		{in: "12.50", want: 12.5},
+		{in: "12,50", want: 12.5},
+		{in: ".5", want: 0.5},
+		{in: ",5", want: 0.5},
+		{in: "-3.25", want: -3.25},
+		{in: "1,200", want: 1200},
+		{in: "1.200", want: 1200},
+		{in: "1,234,567", want: 1234567},
+		{in: "1,234.56", want: 1234.56},
+		{in: "1.234,56", want: 1234.56},
+		{in: "1 234,56", want: 1234.56},
+		{in: "1'234.50", want: 1234.5},
+		{in: "0.500", want: 0.5},
+		{in: "0,750", want: 0.75},
+		{in: "0.001", want: 0.001},
+		{in: "-0,250", want: -0.25},
+		{in: "12.5.6", wantErr: true},
+		{in: "1.234.5", wantErr: true},
+		{in: "12,34.56", wantErr: true},
+		{in: "1.2,3.4", wantErr: true},
+		{in: "1,2,3", wantErr: true},
+		{in: "0.500.000", wantErr: true},
+		{in: "0,500.25", wantErr: true},
+		{in: "012,345", want: 12.345},
+		{in: "1e5", wantErr: true},
+		{in: "", wantErr: true},
+		{in: ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseAmount(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAmount(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseAmount(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	usd := &Currency{Iso: "USD", Symbol: "$"}
+	eur := &Currency{Iso: "EUR", Symbol: "€"}
+	currencies := []*Currency{usd, eur}
+
+	tests := []struct {
+		in           string
+		currencies   []*Currency
+		wantAmount   Amount
+		wantCurrency string
+		wantErr      error
+	}{
+		{in: "12.50 EUR", currencies: currencies, wantAmount: 12.5, wantCurrency: "EUR"},
+		{in: "EUR 12.50", currencies: currencies, wantAmount: 12.5, wantCurrency: "EUR"},
+		{in: "$1,200", currencies: currencies, wantAmount: 1200, wantCurrency: "USD"},
+		{in: "1.200,50 €", currencies: currencies, wantAmount: 1200.5, wantCurrency: "EUR"},
+		{in: "-$5", currencies: currencies, wantAmount: -5, wantCurrency: "USD"},
+		{in: "-3 usd", currencies: currencies, wantAmount: -3, wantCurrency: "USD"},
+		{in: ".5 EUR", currencies: currencies, wantAmount: 0.5, wantCurrency: "EUR"},
+		{in: "12", currencies: currencies, wantAmount: 12},
+		{in: "12 chf", wantAmount: 12, wantCurrency: "CHF"},
+		{in: ".5 EUR", wantAmount: 0.5, wantCurrency: "EUR"},
+		{in: "0.500 EUR", wantAmount: 0.5, wantCurrency: "EUR"},
+		{in: "$1,200", wantErr: ErrUnknownCurrency},
+		{in: "3 XYZ", currencies: currencies, wantErr: ErrUnknownCurrency},
+		{in: "12.5.6 EUR", currencies: currencies, wantErr: errAny},
+		{in: "EUR", currencies: currencies, wantErr: errAny},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMoney(tt.in, tt.currencies)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("ParseMoney(%q) = %v, want error", tt.in, got)
+				}
+				if tt.wantErr != errAny && !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseMoney(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) unexpected error: %v", tt.in, err)
+			}
+
+			if got.Amount != tt.wantAmount {
+				t.Errorf("ParseMoney(%q) amount = %v, want %v", tt.in, got.Amount, tt.wantAmount)
+			}
+			gotCurrency := ""
+			if got.Currency != nil {
+				gotCurrency = got.Currency.Iso
+			}
+			if gotCurrency != tt.wantCurrency {
+				t.Errorf("ParseMoney(%q) currency = %q, want %q", tt.in, gotCurrency, tt.wantCurrency)
+			}
+		})
+	}
+}
+
+// errAny marks test cases expecting any error
+var errAny = errors.New("any error")
+
+func TestMoney_String(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{money: Money{Amount: 12.5}, want: "12.50"},
+		{money: Money{Amount: 12.5, Currency: &Currency{Iso: "USD", Symbol: "$"}}, want: "$12.50"},
+		{money: Money{Amount: -5, Currency: &Currency{Iso: "USD", Symbol: "$"}}, want: "-$5.00"},
+		{money: Money{Amount: 3, Currency: &Currency{Iso: "CHF"}}, want: "3.00 CHF"},
+		{money: Money{Amount: -0.001, Currency: &Currency{Iso: "USD", Symbol: "$"}}, want: "$0.00"},
+		{money: Money{Amount: -0.001, Currency: &Currency{Iso: "CHF"}}, want: "0.00 CHF"},
+		{money: Money{Amount: -0.006, Currency: &Currency{Iso: "USD", Symbol: "$"}}, want: "-$0.01"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestAmount_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{in: `12.5`, want: 12.5},
+		{in: `"12.5"`, want: 12.5},
+		{in: `-3`, want: -3},
+		{in: `"0"`, want: 0},
+		{in: `null`, want: 0},
+		{in: `""`, want: 0},
+		{in: `"twelve"`, wantErr: true},
+		{in: `true`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := Amount(42)
+			err := json.Unmarshal([]byte(tt.in), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
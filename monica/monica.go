@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -23,11 +25,19 @@ const (
 
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
 	headerRetryAfter    = "Retry-After"
 )
 
 var errNonNilContext = errors.New("context must be non-nil")
 
+// ErrEmptyResponse is returned when the API answers successfully but without
+// the data a method needs to continue.
+var ErrEmptyResponse = errors.New("empty response body")
+
+// ErrEmptyLink is returned by Download when there is no link to download.
+var ErrEmptyLink = errors.New("empty download link")
+
 type Client struct {
 	// Base URL for API requests.
 	// BaseURL should always be specified with a trailing slash.
@@ -36,17 +46,54 @@ type Client struct {
 	HTTPClient *http.Client
 	UserAgent  string
 
+	// Retry enables automatic retries of failed requests, nil disables them.
+	Retry *RetryPolicy
+
+	rateMu      sync.Mutex
 	rateLimit   Rate
 	accessToken string
 
+	countriesMu sync.Mutex
+	countries   map[string]*Country
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
-	//Activity *ActivityService
-	Contacts          *ContactsService
-	ContactFieldTypes *ContactFieldTypeService
-	Countries         *CountriesService
-	Genders           *GenderService
-	Tags              *TagsService
+	Activities             *ActivitiesService
+	ActivityTypeCategories *ActivityTypeCategoriesService
+	ActivityTypes          *ActivityTypesService
+	Addresses              *AddressesService
+	AuditLogs              *AuditLogsService
+	Calls                  *CallsService
+	Companies              *CompaniesService
+	Compliance             *ComplianceService
+	Contacts               *ContactsService
+	ContactFields          *ContactFieldsService
+	ContactFieldTypes      *ContactFieldTypeService
+	Conversations          *ConversationsService
+	Countries              *CountriesService
+	Currencies             *CurrenciesService
+	Debts                  *DebtsService
+	Documents              *DocumentsService
+	Genders                *GenderService
+	Gifts                  *GiftsService
+	Groups                 *GroupsService
+	Journal                *JournalService
+	LifeEventCategories    *LifeEventCategoriesService
+	LifeEvents             *LifeEventsService
+	LifeEventTypes         *LifeEventTypesService
+	Notes                  *NotesService
+	Occupations            *OccupationsService
+	PetCategories          *PetCategoriesService
+	Pets                   *PetsService
+	Photos                 *PhotosService
+	Places                 *PlacesService
+	Relationships          *RelationshipsService
+	RelationshipTypeGroups *RelationshipTypeGroupsService
+	RelationshipTypes      *RelationshipTypesService
+	Reminders              *RemindersService
+	Tags                   *TagsService
+	Tasks                  *TasksService
+	User                   *UserService
 }
 
 type service struct {
@@ -82,11 +129,42 @@ func NewClient(baseUrl, accessToken string) *Client {
 
 	client.common.client = client
 
+	client.Activities = (*ActivitiesService)(&client.common)
+	client.ActivityTypeCategories = (*ActivityTypeCategoriesService)(&client.common)
+	client.ActivityTypes = (*ActivityTypesService)(&client.common)
+	client.Addresses = (*AddressesService)(&client.common)
+	client.AuditLogs = (*AuditLogsService)(&client.common)
+	client.Calls = (*CallsService)(&client.common)
+	client.Companies = (*CompaniesService)(&client.common)
+	client.Compliance = (*ComplianceService)(&client.common)
 	client.Contacts = (*ContactsService)(&client.common)
+	client.ContactFields = (*ContactFieldsService)(&client.common)
 	client.ContactFieldTypes = (*ContactFieldTypeService)(&client.common)
+	client.Conversations = (*ConversationsService)(&client.common)
 	client.Countries = (*CountriesService)(&client.common)
+	client.Currencies = (*CurrenciesService)(&client.common)
+	client.Debts = (*DebtsService)(&client.common)
+	client.Documents = (*DocumentsService)(&client.common)
 	client.Genders = (*GenderService)(&client.common)
+	client.Gifts = (*GiftsService)(&client.common)
+	client.Groups = (*GroupsService)(&client.common)
+	client.Journal = (*JournalService)(&client.common)
+	client.LifeEventCategories = (*LifeEventCategoriesService)(&client.common)
+	client.LifeEvents = (*LifeEventsService)(&client.common)
+	client.LifeEventTypes = (*LifeEventTypesService)(&client.common)
+	client.Notes = (*NotesService)(&client.common)
+	client.Occupations = (*OccupationsService)(&client.common)
+	client.PetCategories = (*PetCategoriesService)(&client.common)
+	client.Pets = (*PetsService)(&client.common)
+	client.Photos = (*PhotosService)(&client.common)
+	client.Places = (*PlacesService)(&client.common)
+	client.Relationships = (*RelationshipsService)(&client.common)
+	client.RelationshipTypeGroups = (*RelationshipTypeGroupsService)(&client.common)
+	client.RelationshipTypes = (*RelationshipTypesService)(&client.common)
+	client.Reminders = (*RemindersService)(&client.common)
 	client.Tags = (*TagsService)(&client.common)
+	client.Tasks = (*TasksService)(&client.common)
+	client.User = (*UserService)(&client.common)
 
 	return client
 }
@@ -156,7 +234,52 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// NewMultipartRequest creates an API request with a multipart/form-data body,
+// as used for file uploads. The form contains fields as plain values and the
+// content of file as fileField, named filename. urlStr is resolved like in
+// NewRequest.
+//
+// The body is buffered in memory, so the request can be retried.
+func (c *Client) NewMultipartRequest(method, urlStr string, fields map[string]string, fileField, filename string, file io.Reader) (*http.Request, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile(fileField, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(method, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body := buf.Bytes()
+	req.ContentLength = int64(len(body))
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return req, nil
+}
+
 // Response is a Monica API response. This wraps the standard http.Response.
+//
+// Meta, Links and the page numbers are only populated by Do, as BareDo leaves
+// the body untouched.
 type Response struct {
 	*http.Response
 
@@ -164,16 +287,26 @@ type Response struct {
 	// propagate to Response.
 	Rate Rate
 
-	Meta ListMeta `json:"meta"`
+	Meta  ListMeta  `json:"meta"`
+	Links ListLinks `json:"links"`
+
+	// These fields provide the page values for paginating through a set of
+	// results. Any or all of these may be set to the zero value for
+	// responses that are not part of a paginated set, or for which there
+	// are no additional pages.
+	FirstPage int
+	PrevPage  int
+	NextPage  int
+	LastPage  int
 
 	Data interface{} `json:"data"`
 }
 
 type Rate struct {
-	// The number of requests per hour the client is currently limited to.
+	// The number of requests per minute the client is currently limited to.
 	Limit int `json:"limit"`
 
-	// The number of remaining requests the client can make this hour.
+	// The number of remaining requests the client can make this minute.
 	Remaining int `json:"remaining"`
 
 	// The time at which the current rate limit will reset.
@@ -191,8 +324,61 @@ type ListMeta struct {
 	Total       int    `json:"total"`
 }
 
-func (r *Response) populatePageValues() {
-	//json.NewDecoder(r.Body).Decode(r)
+// ListLinks contains the pagination links returned by API for
+// lists/collections.
+// Links that do not exist, like prev on the first page, are empty.
+type ListLinks struct {
+	First string `json:"first"`
+	Last  string `json:"last"`
+	Prev  string `json:"prev"`
+	Next  string `json:"next"`
+}
+
+// populatePageValues decodes the meta and links blocks of body and sets the
+// page values of r from them.
+func (r *Response) populatePageValues(body []byte) {
+	page := struct {
+		Meta  ListMeta  `json:"meta"`
+		Links ListLinks `json:"links"`
+	}{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return
+	}
+
+	r.Meta = page.Meta
+	r.Links = page.Links
+
+	r.FirstPage = pageFromLink(r.Links.First)
+	r.PrevPage = pageFromLink(r.Links.Prev)
+	r.NextPage = pageFromLink(r.Links.Next)
+	r.LastPage = pageFromLink(r.Links.Last)
+
+	// fall back to the meta block if the links are missing
+	if meta := r.Meta; meta.CurrentPage > 0 && r.Links == (ListLinks{}) {
+		r.FirstPage = 1
+		r.LastPage = meta.LastPage
+		if meta.CurrentPage > 1 {
+			r.PrevPage = meta.CurrentPage - 1
+		}
+		if meta.CurrentPage < meta.LastPage {
+			r.NextPage = meta.CurrentPage + 1
+		}
+	}
+}
+
+// pageFromLink returns the page query parameter of link, or 0.
+func pageFromLink(link string) int {
+	if link == "" {
+		return 0
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+
+	page, _ := strconv.Atoi(u.Query().Get("page"))
+	return page
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -200,7 +386,6 @@ func (r *Response) populatePageValues() {
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.Rate = parseRate(r)
-	//response.populatePageValues()
 	return response
 }
 
@@ -210,6 +395,9 @@ func newResponse(r *http.Response) *Response {
 // and reset time is in the future, BareDo returns *RateLimitError immediately
 // without making a network API call.
 //
+// If c.Retry is set, failed requests are retried according to that policy.
+// Request bodies created by NewRequest are replayed for every attempt.
+//
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
 func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, error) {
@@ -217,6 +405,21 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 		return nil, errNonNilContext
 	}
 
+	if c.Retry != nil && c.Retry.MaxAttempts > 1 {
+		return c.Retry.do(ctx, req, c.bareDo)
+	}
+	return c.bareDo(ctx, req)
+}
+
+// bareDo makes a single attempt at sending req, see BareDo.
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
+	if err := c.checkRateLimitBeforeDo(req); err != nil {
+		return &Response{
+			Response: err.Response,
+			Rate:     err.Rate,
+		}, err
+	}
+
 	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -232,7 +435,9 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 
 	response := newResponse(resp)
 
+	c.rateMu.Lock()
 	c.rateLimit = response.Rate
+	c.rateMu.Unlock()
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -241,6 +446,66 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	return response, err
 }
 
+// Download streams the file at urlStr, like the Link of a Document or Photo.
+// Relative URLs are resolved against BaseURL. The access token is only sent
+// to the host of BaseURL. The caller must close the returned body.
+func (c *Client) Download(ctx context.Context, urlStr string) (io.ReadCloser, error) {
+	if strings.TrimSpace(urlStr) == "" {
+		return nil, ErrEmptyLink
+	}
+
+	req, err := c.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "*/*")
+	if req.URL.Host != c.BaseURL.Host {
+		req.Header.Del("Authorization")
+	}
+
+	resp, err := c.BareDo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// RateLimit returns the rate limit as of the most recent API response. It is
+// safe to call from several goroutines.
+func (c *Client) RateLimit() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rateLimit
+}
+
+// checkRateLimitBeforeDo does not make any network calls, but uses the rate
+// limit of the last response to decide whether req would be throttled anyway.
+// If so, a *RateLimitError wrapping a fake 429 response is returned.
+func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
+	rate := c.RateLimit()
+	if rate.Limit == 0 || rate.Remaining > 0 || !time.Now().Before(rate.Reset.Time) {
+		return nil
+	}
+
+	resp := &http.Response{
+		Status:     http.StatusText(http.StatusTooManyRequests),
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+	return &RateLimitError{
+		Rate:     rate,
+		Response: resp,
+		Message:  "API rate limit still exceeded, not making remote request",
+	}
+}
+
+// Do sends an API request and returns the API response. The API response is
+// JSON decoded and stored in the value pointed to by v, its meta and links
+// blocks are stored in the returned Response.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	resp, err := c.BareDo(ctx, req)
 	if err != nil {
@@ -248,12 +513,18 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}
 	defer resp.Body.Close()
 
-	decErr := json.NewDecoder(resp.Body).Decode(v)
-	if decErr == io.EOF {
-		decErr = nil // ignore EOF errors caused by empty response body
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
 	}
-	if decErr != nil {
-		err = decErr
+	if len(bytes.TrimSpace(body)) == 0 {
+		return resp, nil // ignore empty response body
+	}
+
+	resp.populatePageValues(body)
+
+	if v != nil {
+		err = json.Unmarshal(body, v)
 	}
 
 	return resp, err
@@ -268,6 +539,11 @@ func parseRate(r *http.Response) Rate {
 	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
 		rate.Remaining, _ = strconv.Atoi(remaining)
 	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
+			rate.Reset = Timestamp{time.Unix(v, 0)}
+		}
+	}
 	if reset := r.Header.Get(headerRetryAfter); reset != "" {
 		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
 			rate.Reset = Timestamp{time.Now().Add(time.Duration(v) * time.Second)}
@@ -284,15 +560,26 @@ func parseRate(r *http.Response) Rate {
 //
 // The error type will be *RateLimitError for rate limit exceeded errors,
 // *AcceptedError for 202 Accepted status codes,
-// and *TwoFactorAuthError for two-factor authentication errors.
+// and *ErrorResponse for every other error.
 func CheckResponse(r *http.Response) error {
+	if r.StatusCode == http.StatusAccepted {
+		raw, _ := ioutil.ReadAll(r.Body)
+		return &AcceptedError{Raw: raw}
+	}
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
 	}
 
-	response, _ := ioutil.ReadAll(r.Body)
+	body, _ := ioutil.ReadAll(r.Body)
+	errResp := newErrorResponse(r, body)
+
+	if r.StatusCode == http.StatusTooManyRequests || errResp.ErrorCode == ErrorCodeTooManyAttempts {
+		return &RateLimitError{
+			Rate:     parseRate(r),
+			Response: r,
+			Message:  errResp.Message,
+		}
+	}
 
-	return errors.New(
-		fmt.Sprintf("status code: %d\nResponse:\n%s\n", r.StatusCode, response),
-	)
+	return errResp
 }
@@ -0,0 +1,161 @@
+package monica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// setup creates a test HTTP server and a client talking to it. Handlers are
+// registered on the returned mux, relative to /api/.
+func setup(t *testing.T) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL+"/api/", "token"), mux
+}
+
+func TestBareDo_rateLimitPreCheck(t *testing.T) {
+	client, mux := setup(t)
+
+	var requests int32
+	mux.HandleFunc("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"error":{"message":"Too many attempts","error_code":34}}`)
+	})
+
+	_, _, err := client.Tags.ListTags(context.Background(), nil)
+	var rateErr *RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("first request: expected *RateLimitError, got %v", err)
+	}
+
+	rate := client.RateLimit()
+	if rate.Limit != 60 || rate.Remaining != 0 || rate.Reset.IsZero() {
+		t.Errorf("RateLimit() = %+v, want limit 60, remaining 0 and a reset time", rate)
+	}
+
+	_, _, err = client.Tags.ListTags(context.Background(), nil)
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("second request: expected *RateLimitError, got %v", err)
+	}
+	if rateErr.Response == nil || rateErr.Response.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("second request: expected a fake 429 response, got %+v", rateErr.Response)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestBareDo_nilContext(t *testing.T) {
+	client, _ := setup(t)
+
+	req, _ := client.NewRequest("GET", "tags", nil)
+	_, err := client.BareDo(nil, req)
+	if err != errNonNilContext {
+		t.Errorf("BareDo(nil) error = %v, want %v", err, errNonNilContext)
+	}
+}
+
+func TestDo_populatesPageValues(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want [4]int // first, prev, next, last
+		meta ListMeta
+	}{
+		{
+			name: "links",
+			body: `{"data":[],
+				"links":{"first":"https://m.test/api/tags?page=1","last":"https://m.test/api/tags?page=5","prev":"https://m.test/api/tags?page=2","next":"https://m.test/api/tags?page=4"},
+				"meta":{"current_page":3,"last_page":5,"per_page":10,"total":42}}`,
+			want: [4]int{1, 2, 4, 5},
+			meta: ListMeta{CurrentPage: 3, LastPage: 5, PerPage: 10, Total: 42},
+		},
+		{
+			name: "null links on first page",
+			body: `{"data":[],
+				"links":{"first":"https://m.test/api/tags?page=1","last":"https://m.test/api/tags?page=2","prev":null,"next":"https://m.test/api/tags?page=2"},
+				"meta":{"current_page":1,"last_page":2}}`,
+			want: [4]int{1, 0, 2, 2},
+			meta: ListMeta{CurrentPage: 1, LastPage: 2},
+		},
+		{
+			name: "meta only",
+			body: `{"data":[],"meta":{"current_page":2,"last_page":3}}`,
+			want: [4]int{1, 1, 3, 3},
+			meta: ListMeta{CurrentPage: 2, LastPage: 3},
+		},
+		{
+			name: "not paginated",
+			body: `{"data":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux := setup(t)
+			mux.HandleFunc("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.body)
+			})
+
+			req, _ := client.NewRequest("GET", "tags", nil)
+			v := new(listTagsResponse)
+			resp, err := client.Do(context.Background(), req, v)
+			if err != nil {
+				t.Fatalf("Do() unexpected error: %v", err)
+			}
+
+			got := [4]int{resp.FirstPage, resp.PrevPage, resp.NextPage, resp.LastPage}
+			if got != tt.want {
+				t.Errorf("pages (first, prev, next, last) = %v, want %v", got, tt.want)
+			}
+			if resp.Meta != tt.meta {
+				t.Errorf("Meta = %+v, want %+v", resp.Meta, tt.meta)
+			}
+			if v.Meta != tt.meta {
+				t.Errorf("v was not decoded, Meta = %+v, want %+v", v.Meta, tt.meta)
+			}
+		})
+	}
+}
+
+func TestDo_emptyBody(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/api/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req, _ := client.NewRequest("DELETE", "tags/1", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do() unexpected error: %v", err)
+	}
+}
+
+func TestDownload_emptyLink(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	})
+
+	if _, err := client.Download(context.Background(), ""); err != ErrEmptyLink {
+		t.Errorf("Download(\"\") error = %v, want %v", err, ErrEmptyLink)
+	}
+	if _, err := client.Documents.DownloadDocument(context.Background(), &Document{}); err != ErrEmptyLink {
+		t.Errorf("DownloadDocument() error = %v, want %v", err, ErrEmptyLink)
+	}
+	if _, err := client.Photos.DownloadPhoto(context.Background(), nil); err != ErrEmptyLink {
+		t.Errorf("DownloadPhoto(nil) error = %v, want %v", err, ErrEmptyLink)
+	}
+}
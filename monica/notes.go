@@ -0,0 +1,176 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// NotesService handles communication with the note related methods of the API.
+// API docs: https://www.monicahq.com/api/notes
+type NotesService service
+
+type Note struct {
+	Id          int       `json:"id,omitempty"`
+	Object      string    `json:"object,omitempty"`
+	Body        string    `json:"body"`
+	IsFavorited bool      `json:"is_favorited"`
+	FavoritedAt Timestamp `json:"favorited_at,omitempty"`
+	Account     struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type NoteInput struct {
+	// Body of the note, max 100000 characters
+	Body        string `json:"body"`
+	ContactId   int    `json:"contact_id"`
+	IsFavorited bool   `json:"is_favorited"`
+}
+
+type NoteListOptions struct {
+	ListOptions
+}
+
+type listNotesResponse struct {
+	Data *[]*Note `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// ListNotes Lists the notes of all contacts
+func (s *NotesService) ListNotes(ctx context.Context, opts *NoteListOptions) (*[]*Note, *ListMeta, error) {
+	url, err := addOptions("notes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listNotesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListNotesForContact Lists the notes of a single contact
+func (s *NotesService) ListNotesForContact(ctx context.Context, contactId int, opts *NoteListOptions) (*[]*Note, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/notes", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listNotesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetNote Retrieves a single note
+func (s *NotesService) GetNote(ctx context.Context, id int) (*Note, error) {
+	url := fmt.Sprintf("notes/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Note `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateNote Adds a note to a contact
+func (s *NotesService) CreateNote(ctx context.Context, input *NoteInput) (*Note, error) {
+	req, err := s.client.NewRequest("POST", "notes", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Note `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateNote Updates a note
+func (s *NotesService) UpdateNote(ctx context.Context, id int, input *NoteInput) (*Note, error) {
+	url := fmt.Sprintf("notes/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Note `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteNote Deletes a note
+func (s *NotesService) DeleteNote(ctx context.Context, id int) error {
+	url := fmt.Sprintf("notes/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllNotes walks all pages of notes starting at opts.Page and calls fn for
+// every note. Iteration stops at the first error returned by fn.
+func (s *NotesService) ListAllNotes(ctx context.Context, opts *NoteListOptions, fn func(*Note) error) error {
+	o := NoteListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var notes *[]*Note
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		notes, meta, err = s.ListNotes(ctx, &o)
+		return meta, err
+	}, func() error {
+		if notes == nil {
+			return nil
+		}
+		for _, note := range *notes {
+			if err := fn(note); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
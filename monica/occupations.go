@@ -0,0 +1,346 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// CompaniesService handles communication with the company related methods of the API.
+// API docs: https://www.monicahq.com/api/companies
+type CompaniesService service
+
+// OccupationsService handles communication with the occupation related methods of the API.
+// API docs: https://www.monicahq.com/api/occupations
+type OccupationsService service
+
+type Company struct {
+	Id                int    `json:"id,omitempty"`
+	Object            string `json:"object,omitempty"`
+	Name              string `json:"name"`
+	Website           string `json:"website"`
+	NumberOfEmployees int    `json:"number_of_employees"`
+	Account           struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type CompanyInput struct {
+	// Name of the company, max 255 characters
+	Name              string `json:"name"`
+	Website           string `json:"website,omitempty"`
+	NumberOfEmployees int    `json:"number_of_employees,omitempty"`
+}
+
+// Occupation is a position of a contact at a company
+type Occupation struct {
+	Id          int    `json:"id,omitempty"`
+	Object      string `json:"object,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Salary      Amount `json:"salary"`
+	// Currency of the salary, nil if the account has no currency set
+	Currency *Currency `json:"currency"`
+	// SalaryUnit is the period the salary is paid for, like "year"
+	SalaryUnit         string  `json:"salary_unit"`
+	CurrentlyWorksHere bool    `json:"currently_works_here"`
+	StartDate          Date    `json:"start_date"`
+	EndDate            Date    `json:"end_date"`
+	Company            Company `json:"company"`
+	Account            struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// SalaryMoney returns the salary along with its currency
+func (o *Occupation) SalaryMoney() Money {
+	return Money{Amount: o.Salary, Currency: o.Currency}
+}
+
+type OccupationInput struct {
+	ContactId int `json:"contact_id"`
+	CompanyId int `json:"company_id"`
+	// Title of the position, max 255 characters
+	Title              string  `json:"title"`
+	Description        string  `json:"description,omitempty"`
+	Salary             float64 `json:"salary,omitempty"`
+	SalaryUnit         string  `json:"salary_unit,omitempty"`
+	CurrentlyWorksHere bool    `json:"currently_works_here"`
+	StartDate          Date    `json:"start_date"`
+	EndDate            Date    `json:"end_date"`
+}
+
+type CompanyListOptions struct {
+	ListOptions
+}
+
+type OccupationListOptions struct {
+	ListOptions
+}
+
+type listCompaniesResponse struct {
+	Data *[]*Company `json:"data"`
+	Meta ListMeta    `json:"meta"`
+}
+
+type listOccupationsResponse struct {
+	Data *[]*Occupation `json:"data"`
+	Meta ListMeta       `json:"meta"`
+}
+
+// ListCompanies Lists all companies
+func (s *CompaniesService) ListCompanies(ctx context.Context, opts *CompanyListOptions) (*[]*Company, *ListMeta, error) {
+	url, err := addOptions("companies", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listCompaniesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetCompany Retrieves a single company
+func (s *CompaniesService) GetCompany(ctx context.Context, id int) (*Company, error) {
+	url := fmt.Sprintf("companies/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Company `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateCompany Creates a company
+func (s *CompaniesService) CreateCompany(ctx context.Context, input *CompanyInput) (*Company, error) {
+	req, err := s.client.NewRequest("POST", "companies", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Company `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateCompany Updates a company
+func (s *CompaniesService) UpdateCompany(ctx context.Context, id int, input *CompanyInput) (*Company, error) {
+	url := fmt.Sprintf("companies/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Company `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteCompany Deletes a company
+func (s *CompaniesService) DeleteCompany(ctx context.Context, id int) error {
+	url := fmt.Sprintf("companies/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllCompanies walks all pages of companies starting at opts.Page and calls
+// fn for every company. Iteration stops at the first error returned by fn.
+func (s *CompaniesService) ListAllCompanies(ctx context.Context, opts *CompanyListOptions, fn func(*Company) error) error {
+	o := CompanyListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var companies *[]*Company
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		companies, meta, err = s.ListCompanies(ctx, &o)
+		return meta, err
+	}, func() error {
+		if companies == nil {
+			return nil
+		}
+		for _, company := range *companies {
+			if err := fn(company); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListOccupations Lists the occupations of all contacts
+func (s *OccupationsService) ListOccupations(ctx context.Context, opts *OccupationListOptions) (*[]*Occupation, *ListMeta, error) {
+	url, err := addOptions("occupations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listOccupationsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetOccupation Retrieves a single occupation
+func (s *OccupationsService) GetOccupation(ctx context.Context, id int) (*Occupation, error) {
+	url := fmt.Sprintf("occupations/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Occupation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateOccupation Adds an occupation to the work history of a contact
+func (s *OccupationsService) CreateOccupation(ctx context.Context, input *OccupationInput) (*Occupation, error) {
+	req, err := s.client.NewRequest("POST", "occupations", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Occupation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateOccupation Updates an occupation
+func (s *OccupationsService) UpdateOccupation(ctx context.Context, id int, input *OccupationInput) (*Occupation, error) {
+	url := fmt.Sprintf("occupations/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Occupation `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteOccupation Deletes an occupation
+func (s *OccupationsService) DeleteOccupation(ctx context.Context, id int) error {
+	url := fmt.Sprintf("occupations/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllOccupations walks all pages of occupations starting at opts.Page and
+// calls fn for every occupation. Iteration stops at the first error returned by
+// fn.
+func (s *OccupationsService) ListAllOccupations(ctx context.Context, opts *OccupationListOptions, fn func(*Occupation) error) error {
+	o := OccupationListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var occupations *[]*Occupation
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		occupations, meta, err = s.ListOccupations(ctx, &o)
+		return meta, err
+	}, func() error {
+		if occupations == nil {
+			return nil
+		}
+		for _, occupation := range *occupations {
+			if err := fn(occupation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// OccupationHistory Returns the work history of a single contact, ordered by
+// start date with the most recent occupation last. The API has no contact
+// scoped endpoint, so all occupations are walked and filtered.
+func (s *OccupationsService) OccupationHistory(ctx context.Context, contactId int) ([]*Occupation, error) {
+	var history []*Occupation
+	err := s.ListAllOccupations(ctx, nil, func(occupation *Occupation) error {
+		if occupation.Contact.Id == contactId {
+			history = append(history, occupation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].StartDate.Before(history[j].StartDate.Time)
+	})
+	return history, nil
+}
@@ -0,0 +1,52 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// PageError reports a failure to fetch one page while walking all pages of a
+// list endpoint.
+type PageError struct {
+	// Page is the number of the page that could not be fetched
+	Page int
+	Err  error
+}
+
+func (e *PageError) Error() string {
+	return fmt.Sprintf("fetching page %d: %v", e.Page, e.Err)
+}
+
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// paginate walks all pages of a list endpoint, starting at opts.Page. For
+// every page, fetch is called to load it with the current opts, then emit to
+// hand its items to the caller. Errors of fetch are wrapped in a *PageError,
+// errors of emit are returned as they are and stop the iteration.
+func paginate(ctx context.Context, opts *ListOptions, fetch func() (*ListMeta, error), emit func() error) error {
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		meta, err := fetch()
+		if err != nil {
+			return &PageError{Page: opts.Page, Err: err}
+		}
+
+		if err := emit(); err != nil {
+			return err
+		}
+
+		if meta == nil || meta.CurrentPage >= meta.LastPage {
+			return nil
+		}
+		opts.Page = meta.CurrentPage + 1
+	}
+}
@@ -0,0 +1,146 @@
+package monica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// servePages serves three pages of two tags each on /api/tags.
+func servePages(t *testing.T, mux *http.ServeMux, failPage int) {
+	t.Helper()
+
+	mux.HandleFunc("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"data":[{"id":%d},{"id":%d}],"meta":{"current_page":%d,"last_page":3}}`,
+			2*page-1, 2*page, page)
+	})
+}
+
+func TestListAllTags(t *testing.T) {
+	client, mux := setup(t)
+	servePages(t, mux, 0)
+
+	var ids []int
+	err := client.Tags.ListAllTags(context.Background(), nil, func(tag *Tag) error {
+		ids = append(ids, tag.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListAllTags() unexpected error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListAllTags() ids = %v, want %v", ids, want)
+	}
+}
+
+func TestListAllTags_startPage(t *testing.T) {
+	client, mux := setup(t)
+	servePages(t, mux, 0)
+
+	var ids []int
+	opts := &TagListOptions{ListOptions{Page: 2}}
+	err := client.Tags.ListAllTags(context.Background(), opts, func(tag *Tag) error {
+		ids = append(ids, tag.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListAllTags() unexpected error: %v", err)
+	}
+	if want := []int{3, 4, 5, 6}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListAllTags() ids = %v, want %v", ids, want)
+	}
+}
+
+func TestListAllTags_callbackError(t *testing.T) {
+	client, mux := setup(t)
+	servePages(t, mux, 0)
+
+	stop := errors.New("stop")
+	var ids []int
+	err := client.Tags.ListAllTags(context.Background(), nil, func(tag *Tag) error {
+		ids = append(ids, tag.Id)
+		if tag.Id == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Fatalf("ListAllTags() error = %v, want %v", err, stop)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListAllTags() ids = %v, want %v", ids, want)
+	}
+}
+
+func TestListAllTags_pageError(t *testing.T) {
+	client, mux := setup(t)
+	servePages(t, mux, 2)
+
+	var ids []int
+	err := client.Tags.ListAllTags(context.Background(), nil, func(tag *Tag) error {
+		ids = append(ids, tag.Id)
+		return nil
+	})
+
+	var pageErr *PageError
+	if !errors.As(err, &pageErr) {
+		t.Fatalf("ListAllTags() error = %v, want *PageError", err)
+	}
+	if pageErr.Page != 2 {
+		t.Errorf("PageError.Page = %d, want 2", pageErr.Page)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("ListAllTags() error = %v, want it to wrap a 500 *ErrorResponse", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListAllTags() ids = %v, want %v", ids, want)
+	}
+}
+
+func TestPaginate_contextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var fetched []int
+	opts := &ListOptions{}
+	err := paginate(ctx, opts, func() (*ListMeta, error) {
+		fetched = append(fetched, opts.Page)
+		return &ListMeta{CurrentPage: opts.Page, LastPage: 5}, nil
+	}, func() error {
+		if opts.Page == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("paginate() error = %v, want %v", err, context.Canceled)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("paginate() fetched pages %v, want %v", fetched, want)
+	}
+}
+
+func TestPaginate_nilMeta(t *testing.T) {
+	calls := 0
+	err := paginate(context.Background(), &ListOptions{}, func() (*ListMeta, error) {
+		calls++
+		return nil, nil
+	}, func() error { return nil })
+
+	if err != nil {
+		t.Fatalf("paginate() unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("paginate() fetched %d pages, want 1", calls)
+	}
+}
@@ -0,0 +1,238 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// PetsService handles communication with the pet related methods of the API.
+// API docs: https://www.monicahq.com/api/pets
+type PetsService service
+
+// PetCategoriesService handles communication with the pet category related
+// methods of the API.
+// API docs: https://www.monicahq.com/api/petcategories
+type PetCategoriesService service
+
+type Pet struct {
+	Id          int         `json:"id,omitempty"`
+	Object      string      `json:"object,omitempty"`
+	Name        string      `json:"name"`
+	PetCategory PetCategory `json:"pet_category"`
+	Account     struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type PetInput struct {
+	ContactId     int `json:"contact_id"`
+	PetCategoryId int `json:"pet_category_id"`
+	// Name of the pet, max 255 characters
+	Name string `json:"name,omitempty"`
+}
+
+// PetCategory is a kind of pet, like "dog"
+type PetCategory struct {
+	Id     int    `json:"id,omitempty"`
+	Object string `json:"object,omitempty"`
+	Name   string `json:"name"`
+	// IsCommon marks the categories shown first in Monica's UI
+	IsCommon bool `json:"is_common"`
+}
+
+type PetListOptions struct {
+	ListOptions
+}
+
+type PetCategoryListOptions struct {
+	ListOptions
+}
+
+type listPetsResponse struct {
+	Data *[]*Pet  `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+type listPetCategoriesResponse struct {
+	Data *[]*PetCategory `json:"data"`
+	Meta ListMeta        `json:"meta"`
+}
+
+// ListPets Lists the pets of all contacts
+func (s *PetsService) ListPets(ctx context.Context, opts *PetListOptions) (*[]*Pet, *ListMeta, error) {
+	url, err := addOptions("pets", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listPetsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListPetsForContact Lists the pets of a single contact
+func (s *PetsService) ListPetsForContact(ctx context.Context, contactId int, opts *PetListOptions) (*[]*Pet, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/pets", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listPetsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetPet Retrieves a single pet
+func (s *PetsService) GetPet(ctx context.Context, id int) (*Pet, error) {
+	url := fmt.Sprintf("pets/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Pet `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreatePet Adds a pet to a contact
+func (s *PetsService) CreatePet(ctx context.Context, input *PetInput) (*Pet, error) {
+	req, err := s.client.NewRequest("POST", "pets", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Pet `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdatePet Updates a pet
+func (s *PetsService) UpdatePet(ctx context.Context, id int, input *PetInput) (*Pet, error) {
+	url := fmt.Sprintf("pets/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Pet `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeletePet Deletes a pet
+func (s *PetsService) DeletePet(ctx context.Context, id int) error {
+	url := fmt.Sprintf("pets/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllPets walks all pages of pets starting at opts.Page and calls fn for
+// every pet. Iteration stops at the first error returned by fn.
+func (s *PetsService) ListAllPets(ctx context.Context, opts *PetListOptions, fn func(*Pet) error) error {
+	o := PetListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var pets *[]*Pet
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		pets, meta, err = s.ListPets(ctx, &o)
+		return meta, err
+	}, func() error {
+		if pets == nil {
+			return nil
+		}
+		for _, pet := range *pets {
+			if err := fn(pet); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListPetCategories Lists all pet categories
+func (s *PetCategoriesService) ListPetCategories(ctx context.Context, opts *PetCategoryListOptions) (*[]*PetCategory, *ListMeta, error) {
+	url, err := addOptions("petcategories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listPetCategoriesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetPetCategory Retrieves a single pet category
+func (s *PetCategoriesService) GetPetCategory(ctx context.Context, id int) (*PetCategory, error) {
+	url := fmt.Sprintf("petcategories/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *PetCategory `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
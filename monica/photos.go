@@ -0,0 +1,142 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// PhotosService handles communication with the photo related methods of the API.
+// API docs: https://www.monicahq.com/api/photos
+type PhotosService service
+
+type Photo struct {
+	Id               int    `json:"id,omitempty"`
+	Object           string `json:"object,omitempty"`
+	OriginalFilename string `json:"original_filename"`
+	NewFilename      string `json:"new_filename"`
+	// Filesize is the size in bytes
+	Filesize int    `json:"filesize"`
+	MimeType string `json:"mime_type"`
+	// Link is the URL of the image, see DownloadPhoto
+	Link    string `json:"link"`
+	Account struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type PhotoListOptions struct {
+	ListOptions
+}
+
+type listPhotosResponse struct {
+	Data *[]*Photo `json:"data"`
+	Meta ListMeta  `json:"meta"`
+}
+
+// ListPhotos Lists the photos of all contacts
+func (s *PhotosService) ListPhotos(ctx context.Context, opts *PhotoListOptions) (*[]*Photo, *ListMeta, error) {
+	url, err := addOptions("photos", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listPhotosResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListPhotosForContact Lists the photos of a single contact
+func (s *PhotosService) ListPhotosForContact(ctx context.Context, contactId int, opts *PhotoListOptions) (*[]*Photo, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/photos", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listPhotosResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetPhoto Retrieves the metadata of a single photo
+func (s *PhotosService) GetPhoto(ctx context.Context, id int) (*Photo, error) {
+	url := fmt.Sprintf("photos/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Photo `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UploadPhoto Uploads a photo and attaches it to a contact. Only
+// image files are accepted.
+func (s *PhotosService) UploadPhoto(ctx context.Context, contactId int, filename string, file io.Reader) (*Photo, error) {
+	fields := map[string]string{"contact_id": strconv.Itoa(contactId)}
+	req, err := s.client.NewMultipartRequest("POST", "photos", fields, "photo", filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Photo `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DownloadPhoto Streams the content of a photo, the caller must close the
+// returned body
+func (s *PhotosService) DownloadPhoto(ctx context.Context, photo *Photo) (io.ReadCloser, error) {
+	if photo == nil {
+		return nil, ErrEmptyLink
+	}
+	return s.client.Download(ctx, photo.Link)
+}
+
+// DeletePhoto Deletes a photo
+func (s *PhotosService) DeletePhoto(ctx context.Context, id int) error {
+	url := fmt.Sprintf("photos/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
@@ -0,0 +1,308 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+)
+
+// RelationshipsService handles communication with the relationship related methods of the API.
+// API docs: https://www.monicahq.com/api/relationships
+type RelationshipsService service
+
+// RelationshipTypesService handles communication with the relationship type
+// related methods of the API.
+// API docs: https://www.monicahq.com/api/relationshiptypes
+type RelationshipTypesService service
+
+// RelationshipTypeGroupsService handles communication with the relationship
+// type group related methods of the API.
+// API docs: https://www.monicahq.com/api/relationshiptypegroups
+type RelationshipTypeGroupsService service
+
+// Names of the relationship type groups every account has
+const (
+	RelationshipGroupLove   = "love"
+	RelationshipGroupFamily = "family"
+	RelationshipGroupFriend = "friend"
+	RelationshipGroupWork   = "work"
+)
+
+// Relationship links two contacts: ContactIs is the RelationshipType of
+// OfContact, e.g. ContactIs is the "father" of OfContact
+type Relationship struct {
+	Id               int              `json:"id,omitempty"`
+	Object           string           `json:"object,omitempty"`
+	ContactIs        Contact          `json:"contact_is"`
+	RelationshipType RelationshipType `json:"relationship_type"`
+	OfContact        Contact          `json:"of_contact"`
+	Account          struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type RelationshipInput struct {
+	ContactIs          int `json:"contact_is"`
+	RelationshipTypeId int `json:"relationship_type_id"`
+	OfContact          int `json:"of_contact"`
+}
+
+type updateRelationshipInput struct {
+	RelationshipTypeId int `json:"relationship_type_id"`
+}
+
+type RelationshipType struct {
+	Id     int    `json:"id,omitempty"`
+	Object string `json:"object,omitempty"`
+	Name   string `json:"name"`
+	// NameReverseRelationship is the name of the type seen from the other
+	// contact, e.g. "child" for "parent"
+	NameReverseRelationship string `json:"name_reverse_relationship"`
+	RelationshipTypeGroupId int    `json:"relationship_type_group_id"`
+	Delible                 bool   `json:"delible"`
+	Account                 struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type RelationshipTypeGroup struct {
+	Id      int    `json:"id,omitempty"`
+	Object  string `json:"object,omitempty"`
+	Name    string `json:"name"`
+	Delible bool   `json:"delible"`
+	Account struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type RelationshipListOptions struct {
+	ListOptions
+}
+
+type RelationshipTypeListOptions struct {
+	ListOptions
+}
+
+type RelationshipTypeGroupListOptions struct {
+	ListOptions
+}
+
+type listRelationshipsResponse struct {
+	Data *[]*Relationship `json:"data"`
+	Meta ListMeta         `json:"meta"`
+}
+
+type listRelationshipTypesResponse struct {
+	Data *[]*RelationshipType `json:"data"`
+	Meta ListMeta             `json:"meta"`
+}
+
+type listRelationshipTypeGroupsResponse struct {
+	Data *[]*RelationshipTypeGroup `json:"data"`
+	Meta ListMeta                  `json:"meta"`
+}
+
+// ListRelationshipsForContact Lists the relationships of a single contact
+func (s *RelationshipsService) ListRelationshipsForContact(ctx context.Context, contactId int, opts *RelationshipListOptions) (*[]*Relationship, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/relationships", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listRelationshipsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetRelationship Retrieves a single relationship
+func (s *RelationshipsService) GetRelationship(ctx context.Context, id int) (*Relationship, error) {
+	url := fmt.Sprintf("relationships/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Relationship `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateRelationship Creates a relationship between two contacts, Monica adds
+// the reverse relationship automatically
+func (s *RelationshipsService) CreateRelationship(ctx context.Context, input *RelationshipInput) (*Relationship, error) {
+	req, err := s.client.NewRequest("POST", "relationships", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Relationship `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateRelationship Changes the type of a relationship
+func (s *RelationshipsService) UpdateRelationship(ctx context.Context, id int, relationshipTypeId int) (*Relationship, error) {
+	url := fmt.Sprintf("relationships/%d", id)
+	body := updateRelationshipInput{RelationshipTypeId: relationshipTypeId}
+	req, err := s.client.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Relationship `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteRelationship Deletes a relationship along with its reverse relationship
+func (s *RelationshipsService) DeleteRelationship(ctx context.Context, id int) error {
+	url := fmt.Sprintf("relationships/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListRelationshipTypes Lists all relationship types
+func (s *RelationshipTypesService) ListRelationshipTypes(ctx context.Context, opts *RelationshipTypeListOptions) (*[]*RelationshipType, *ListMeta, error) {
+	url, err := addOptions("relationshiptypes", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listRelationshipTypesResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetRelationshipType Retrieves a single relationship type
+func (s *RelationshipTypesService) GetRelationshipType(ctx context.Context, id int) (*RelationshipType, error) {
+	url := fmt.Sprintf("relationshiptypes/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *RelationshipType `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListRelationshipTypeGroups Lists all relationship type groups
+func (s *RelationshipTypeGroupsService) ListRelationshipTypeGroups(ctx context.Context, opts *RelationshipTypeGroupListOptions) (*[]*RelationshipTypeGroup, *ListMeta, error) {
+	url, err := addOptions("relationshiptypegroups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listRelationshipTypeGroupsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetRelationshipTypeGroup Retrieves a single relationship type group
+func (s *RelationshipTypeGroupsService) GetRelationshipTypeGroup(ctx context.Context, id int) (*RelationshipTypeGroup, error) {
+	url := fmt.Sprintf("relationshiptypegroups/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *RelationshipTypeGroup `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListAllRelationshipTypeGroups walks all pages of relationship type groups
+// starting at opts.Page and calls fn for every group. Iteration stops at the
+// first error returned by fn.
+func (s *RelationshipTypeGroupsService) ListAllRelationshipTypeGroups(ctx context.Context, opts *RelationshipTypeGroupListOptions, fn func(*RelationshipTypeGroup) error) error {
+	o := RelationshipTypeGroupListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var groups *[]*RelationshipTypeGroup
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		groups, meta, err = s.ListRelationshipTypeGroups(ctx, &o)
+		return meta, err
+	}, func() error {
+		if groups == nil {
+			return nil
+		}
+		for _, group := range *groups {
+			if err := fn(group); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
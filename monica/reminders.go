@@ -0,0 +1,320 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RemindersService handles communication with the reminder related methods of the API.
+// API docs: https://www.monicahq.com/api/reminders
+type RemindersService service
+
+// ReminderFrequencyType defines how often a reminder recurs
+type ReminderFrequencyType string
+
+const (
+	ReminderOneTime ReminderFrequencyType = "one_time"
+	ReminderWeekly  ReminderFrequencyType = "week"
+	ReminderMonthly ReminderFrequencyType = "month"
+	ReminderYearly  ReminderFrequencyType = "year"
+)
+
+type Reminder struct {
+	Id          int    `json:"id,omitempty"`
+	Object      string `json:"object,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// FrequencyType is the unit of the recurrence
+	FrequencyType ReminderFrequencyType `json:"frequency_type"`
+	// FrequencyNumber is the number of FrequencyType units between two
+	// occurrences, e.g. 2 with ReminderWeekly recurs every other week
+	FrequencyNumber int  `json:"frequency_number"`
+	InitialDate     Date `json:"initial_date"`
+	Delible         bool `json:"delible"`
+	Account         struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	Contact Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type ReminderInput struct {
+	// Title of the reminder, max 100000 characters
+	Title string `json:"title"`
+	// Description of the reminder, max 1000000 characters
+	Description     string                `json:"description,omitempty"`
+	InitialDate     Date                  `json:"initial_date"`
+	FrequencyType   ReminderFrequencyType `json:"frequency_type"`
+	FrequencyNumber int                   `json:"frequency_number,omitempty"`
+	ContactId       int                   `json:"contact_id"`
+}
+
+// ReminderOccurrence is a single occurrence of a possibly recurring reminder
+type ReminderOccurrence struct {
+	Reminder *Reminder
+	Date     time.Time
+}
+
+type ReminderListOptions struct {
+	ListOptions
+}
+
+type listRemindersResponse struct {
+	Data *[]*Reminder `json:"data"`
+	Meta ListMeta     `json:"meta"`
+}
+
+// ListReminders Lists the reminders of all contacts
+func (s *RemindersService) ListReminders(ctx context.Context, opts *ReminderListOptions) (*[]*Reminder, *ListMeta, error) {
+	url, err := addOptions("reminders", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listRemindersResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListRemindersForContact Lists the reminders of a single contact
+func (s *RemindersService) ListRemindersForContact(ctx context.Context, contactId int, opts *ReminderListOptions) (*[]*Reminder, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/reminders", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listRemindersResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetReminder Retrieves a single reminder
+func (s *RemindersService) GetReminder(ctx context.Context, id int) (*Reminder, error) {
+	url := fmt.Sprintf("reminders/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Reminder `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateReminder Creates a reminder for a contact
+func (s *RemindersService) CreateReminder(ctx context.Context, input *ReminderInput) (*Reminder, error) {
+	req, err := s.client.NewRequest("POST", "reminders", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Reminder `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateReminder Updates a reminder
+func (s *RemindersService) UpdateReminder(ctx context.Context, id int, input *ReminderInput) (*Reminder, error) {
+	url := fmt.Sprintf("reminders/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Reminder `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteReminder Deletes a reminder
+func (s *RemindersService) DeleteReminder(ctx context.Context, id int) error {
+	url := fmt.Sprintf("reminders/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListAllReminders walks all pages of reminders starting at opts.Page and calls
+// fn for every reminder. Iteration stops at the first error returned by fn.
+func (s *RemindersService) ListAllReminders(ctx context.Context, opts *ReminderListOptions, fn func(*Reminder) error) error {
+	o := ReminderListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var reminders *[]*Reminder
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		reminders, meta, err = s.ListReminders(ctx, &o)
+		return meta, err
+	}, func() error {
+		if reminders == nil {
+			return nil
+		}
+		for _, reminder := range *reminders {
+			if err := fn(reminder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NextOccurrences computes up to n dates, on or after the calendar day of
+// from, at which the reminder is due. Passing time.Now() therefore includes
+// today's occurrence. One time reminders have at most one occurrence.
+//
+// Monthly and yearly reminders starting on a day the target month does not
+// have, like January 31st, fall on the last day of that month instead.
+func (r *Reminder) NextOccurrences(from time.Time, n int) []time.Time {
+	initial := r.InitialDate.Time
+	if initial.IsZero() || n <= 0 {
+		return nil
+	}
+
+	day := truncateToDay(from, initial.Location())
+
+	if r.FrequencyType == ReminderOneTime || r.FrequencyType == "" {
+		if !initial.Before(day) {
+			return []time.Time{initial}
+		}
+		return nil
+	}
+
+	step := r.FrequencyNumber
+	if step < 1 {
+		step = 1
+	}
+
+	// skip the occurrences before day without iterating over all of them
+	k := 0
+	if day.After(initial) {
+		switch r.FrequencyType {
+		case ReminderWeekly:
+			k = int(day.Sub(initial).Hours()/24) / (7 * step)
+		case ReminderMonthly:
+			k = monthsBetween(initial, day) / step
+		case ReminderYearly:
+			k = (day.Year() - initial.Year()) / step
+		}
+		if k > 0 {
+			k--
+		}
+	}
+
+	var occurrences []time.Time
+	for ; len(occurrences) < n; k++ {
+		var next time.Time
+		switch r.FrequencyType {
+		case ReminderWeekly:
+			next = initial.AddDate(0, 0, 7*step*k)
+		case ReminderMonthly:
+			next = addMonthsClamped(initial, step*k)
+		case ReminderYearly:
+			next = addMonthsClamped(initial, 12*step*k)
+		default:
+			return occurrences
+		}
+
+		if !next.Before(day) {
+			occurrences = append(occurrences, next)
+		}
+	}
+
+	return occurrences
+}
+
+// UpcomingReminders returns all occurrences of reminders from the calendar
+// day of from up to and including the day of until, ordered by date. Use it
+// to build a digest of due reminders.
+func UpcomingReminders(reminders []*Reminder, from, until time.Time) []ReminderOccurrence {
+	var upcoming []ReminderOccurrence
+	for _, reminder := range reminders {
+		lastDay := truncateToDay(until, reminder.InitialDate.Location())
+		next := from
+		for {
+			dates := reminder.NextOccurrences(next, 1)
+			if len(dates) == 0 || dates[0].After(lastDay) {
+				break
+			}
+			upcoming = append(upcoming, ReminderOccurrence{Reminder: reminder, Date: dates[0]})
+			next = dates[0].AddDate(0, 0, 1)
+		}
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].Date.Before(upcoming[j].Date)
+	})
+	return upcoming
+}
+
+// truncateToDay returns midnight in loc of the calendar day t falls on in its
+// own location
+func truncateToDay(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// monthsBetween returns the number of full months from a to b
+func monthsBetween(a, b time.Time) int {
+	months := (b.Year()-a.Year())*12 + int(b.Month()) - int(a.Month())
+	if b.Day() < a.Day() {
+		months--
+	}
+	return months
+}
+
+// addMonthsClamped adds months to t, using the last day of the target month
+// if it is shorter than t's day
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
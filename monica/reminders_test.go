@@ -0,0 +1,107 @@
+package monica
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReminder_NextOccurrences(t *testing.T) {
+	noon := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		reminder Reminder
+		from     time.Time
+		n        int
+		want     []Date
+	}{
+		{
+			name:     "yearly includes today",
+			reminder: Reminder{FrequencyType: ReminderYearly, FrequencyNumber: 1, InitialDate: NewDate(2000, 10, 17)},
+			from:     noon,
+			n:        2,
+			want:     []Date{NewDate(2026, 10, 17), NewDate(2027, 10, 17)},
+		},
+		{
+			name:     "one time today",
+			reminder: Reminder{FrequencyType: ReminderOneTime, InitialDate: NewDate(2026, 10, 17)},
+			from:     noon,
+			n:        3,
+			want:     []Date{NewDate(2026, 10, 17)},
+		},
+		{
+			name:     "one time in the past",
+			reminder: Reminder{FrequencyType: ReminderOneTime, InitialDate: NewDate(2026, 10, 16)},
+			from:     noon,
+			n:        1,
+			want:     nil,
+		},
+		{
+			name:     "monthly clamps to month end",
+			reminder: Reminder{FrequencyType: ReminderMonthly, FrequencyNumber: 1, InitialDate: NewDate(2024, 1, 31)},
+			from:     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			n:        3,
+			want:     []Date{NewDate(2024, 2, 29), NewDate(2024, 3, 31), NewDate(2024, 4, 30)},
+		},
+		{
+			name:     "yearly leap day",
+			reminder: Reminder{FrequencyType: ReminderYearly, FrequencyNumber: 1, InitialDate: NewDate(2016, 2, 29)},
+			from:     noon,
+			n:        2,
+			want:     []Date{NewDate(2027, 2, 28), NewDate(2028, 2, 29)},
+		},
+		{
+			name:     "weekly skip ahead with step",
+			reminder: Reminder{FrequencyType: ReminderWeekly, FrequencyNumber: 2, InitialDate: NewDate(2000, 1, 6)},
+			from:     noon,
+			n:        2,
+			want:     []Date{NewDate(2026, 10, 22), NewDate(2026, 11, 5)},
+		},
+		{
+			name:     "monthly skip ahead with step",
+			reminder: Reminder{FrequencyType: ReminderMonthly, FrequencyNumber: 3, InitialDate: NewDate(2001, 1, 17)},
+			from:     noon,
+			n:        2,
+			want:     []Date{NewDate(2026, 10, 17), NewDate(2027, 1, 17)},
+		},
+		{
+			name:     "starts in the future",
+			reminder: Reminder{FrequencyType: ReminderMonthly, FrequencyNumber: 1, InitialDate: NewDate(2030, 1, 15)},
+			from:     noon,
+			n:        2,
+			want:     []Date{NewDate(2030, 1, 15), NewDate(2030, 2, 15)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Date
+			for _, occurrence := range tt.reminder.NextOccurrences(tt.from, tt.n) {
+				got = append(got, Date{occurrence})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NextOccurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpcomingReminders(t *testing.T) {
+	weekly := &Reminder{FrequencyType: ReminderWeekly, FrequencyNumber: 1, InitialDate: NewDate(2026, 10, 3)}
+	yearly := &Reminder{FrequencyType: ReminderYearly, FrequencyNumber: 1, InitialDate: NewDate(1990, 10, 20)}
+
+	from := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 10, 24, 8, 0, 0, 0, time.UTC)
+
+	got := UpcomingReminders([]*Reminder{weekly, yearly}, from, until)
+
+	want := []ReminderOccurrence{
+		{Reminder: weekly, Date: NewDate(2026, 10, 17).Time},
+		{Reminder: yearly, Date: NewDate(2026, 10, 20).Time},
+		{Reminder: weekly, Date: NewDate(2026, 10, 24).Time},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UpcomingReminders() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,177 @@
+package monica
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of failed requests. Requests are
+// retried on 429 Too Many Requests, 502, 503 and 504 responses and on transient
+// network errors. Set Client.Retry to enable it, a nil policy disables retries.
+//
+// A timeout, a dropped connection or a 502/504 leaves open whether the server
+// has applied the request, so they are only retried for the idempotent methods
+// GET, HEAD, PUT and DELETE. Other methods like POST are only retried if the
+// request was rejected before being processed: on 429, on 503, when the
+// connection was refused, or when the client blocked it because the rate
+// limit was exhausted.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+
+	// MinBackoff is the wait before the first retry. It is doubled for every
+	// further retry, and randomized by up to half of its value.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the wait between two attempts. If the server asks for a
+	// longer wait via Retry-After, the error is returned instead. Zero means
+	// no cap.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for Monica's per-minute
+// rate limit.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  time.Minute + 5*time.Second,
+	}
+}
+
+// do runs send until it succeeds, fails with a permanent error, the attempts
+// are used up or ctx is done.
+func (p *RetryPolicy) do(ctx context.Context, req *http.Request, send func(context.Context, *http.Request) (*Response, error)) (*Response, error) {
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := send(ctx, attemptReq)
+		if err == nil || attempt >= p.MaxAttempts {
+			return resp, err
+		}
+
+		wait, ok := p.backoff(attempt, req.Method, resp, err)
+		if !ok {
+			return resp, err
+		}
+
+		// the body of the previous attempt has been consumed, get a fresh copy
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the time to wait before the next attempt, and whether the
+// request should be retried at all.
+func (p *RetryPolicy) backoff(attempt int, method string, resp *Response, err error) (time.Duration, bool) {
+	if !isRetryable(method, resp, err) {
+		return 0, false
+	}
+
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) && !rateErr.Rate.Reset.IsZero() {
+		wait := time.Until(rateErr.Rate.Reset.Time)
+		if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+			return 0, false
+		}
+		if wait > 0 {
+			return wait, true
+		}
+	}
+
+	if resp != nil && resp.Response != nil {
+		if retryAfter := resp.Header.Get(headerRetryAfter); retryAfter != "" {
+			if v, _ := strconv.ParseInt(retryAfter, 10, 64); v > 0 {
+				wait := time.Duration(v) * time.Second
+				if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+					return 0, false
+				}
+				return wait, true
+			}
+		}
+	}
+
+	wait := p.MinBackoff << (attempt - 1)
+	if p.MaxBackoff > 0 && (wait <= 0 || wait > p.MaxBackoff) {
+		wait = p.MaxBackoff
+	}
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int63n(half+1))
+	}
+	return wait, true
+}
+
+// isRetryable reports whether a request with method that failed with resp and
+// err might succeed when sent again, without risking to apply it twice.
+func isRetryable(method string, resp *Response, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return true
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		switch errResp.StatusCode {
+		case http.StatusServiceUnavailable:
+			return true
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			return isIdempotent(method)
+		}
+		return false
+	}
+
+	// no response at all, look for transient network errors
+	if resp != nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	if !isIdempotent(method) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// isIdempotent reports whether sending a request with method several times
+// has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
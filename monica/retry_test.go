@@ -0,0 +1,204 @@
+package monica
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetry_rateLimitedThenOK(t *testing.T) {
+	client, mux := setup(t)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Second}
+
+	var requests int32
+	mux.HandleFunc("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		var body createTagRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("attempt %d: decoding body: %v", atomic.LoadInt32(&requests)+1, err)
+		}
+		if body.Name != "friends" {
+			t.Errorf("attempt %d: body name = %q, want %q", atomic.LoadInt32(&requests)+1, body.Name, "friends")
+		}
+
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":1,"name":"friends"}}`)
+	})
+
+	tag, err := client.Tags.CreateTag(context.Background(), "friends")
+	if err != nil {
+		t.Fatalf("CreateTag() unexpected error: %v", err)
+	}
+	if tag.Id != 1 || tag.Name != "friends" {
+		t.Errorf("CreateTag() = %+v, want id 1 named friends", tag)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("server received %d requests, want 2", got)
+	}
+}
+
+func TestRetry_serverErrorExhaustsAttempts(t *testing.T) {
+	client, mux := setup(t)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var requests int32
+	mux.HandleFunc("/api/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Tags.GetTag(context.Background(), 1)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("GetTag() error = %v, want 503 *ErrorResponse", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("server received %d requests, want 3", got)
+	}
+}
+
+func TestRetry_notRetryable(t *testing.T) {
+	client, mux := setup(t)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var requests int32
+	mux.HandleFunc("/api/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := client.Tags.GetTag(context.Background(), 1); !IsNotFound(err) {
+		t.Fatalf("GetTag() error = %v, want not found", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestRetry_maxBackoffExceeded(t *testing.T) {
+	client, mux := setup(t)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second}
+
+	var requests int32
+	mux.HandleFunc("/api/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set(headerRetryAfter, "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	start := time.Now()
+	_, err := client.Tags.GetTag(context.Background(), 1)
+	if !IsRateLimited(err) {
+		t.Fatalf("GetTag() error = %v, want rate limit error", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("GetTag() returned after %v, expected no wait", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestRetry_contextCancelledDuringWait(t *testing.T) {
+	client, mux := setup(t)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: 10 * time.Second}
+
+	var requests int32
+	mux.HandleFunc("/api/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Tags.GetTag(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetTag() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("GetTag() returned after %v, expected it to stop waiting", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestRetry_postNotRetriedOnBadGateway(t *testing.T) {
+	client, mux := setup(t)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var requests int32
+	mux.HandleFunc("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.Tags.CreateTag(context.Background(), "friends")
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusBadGateway {
+		t.Fatalf("CreateTag() error = %v, want 502 *ErrorResponse", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	response := func(status int) *Response {
+		return &Response{Response: &http.Response{StatusCode: status}}
+	}
+	errorResponse := func(status int) error {
+		return &ErrorResponse{Response: &http.Response{StatusCode: status}, StatusCode: status}
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		resp   *Response
+		err    error
+		want   bool
+	}{
+		{"GET 429", "GET", response(429), &RateLimitError{}, true},
+		{"POST 429", "POST", response(429), &RateLimitError{}, true},
+		{"GET 502", "GET", response(502), errorResponse(502), true},
+		{"POST 502", "POST", response(502), errorResponse(502), false},
+		{"POST 503", "POST", response(503), errorResponse(503), true},
+		{"PUT 504", "PUT", response(504), errorResponse(504), true},
+		{"POST 504", "POST", response(504), errorResponse(504), false},
+		{"GET 500", "GET", response(500), errorResponse(500), false},
+		{"DELETE 404", "DELETE", response(404), errorResponse(404), false},
+		{"GET connection reset", "GET", nil, syscall.ECONNRESET, true},
+		{"POST connection reset", "POST", nil, syscall.ECONNRESET, false},
+		{"POST connection refused", "POST", nil, syscall.ECONNREFUSED, true},
+		{"DELETE EOF", "DELETE", nil, io.EOF, true},
+		{"POST EOF", "POST", nil, io.EOF, false},
+		{"GET timeout", "GET", nil, timeoutError{}, true},
+		{"POST timeout", "POST", nil, timeoutError{}, false},
+		{"GET canceled", "GET", nil, context.Canceled, false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryable(tt.method, tt.resp, tt.err); got != tt.want {
+			t.Errorf("%s: isRetryable() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// timeoutError is a net.Error reporting a timeout
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
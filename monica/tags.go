@@ -15,7 +15,7 @@ type Tag struct {
 	Name     string `json:"name"`
 	NameSlug string `json:"name_slug,omitempty"`
 
-	Account  struct {
+	Account struct {
 		Id int `json:"id,omitempty"`
 	} `json:"account,omitempty"`
 
@@ -28,7 +28,7 @@ type TagListOptions struct {
 }
 
 type listTagsResponse struct {
-	Data *[]*Tag `json:"data"`
+	Data *[]*Tag  `json:"data"`
 	Meta ListMeta `json:"meta"`
 }
 
@@ -122,14 +122,52 @@ func (s *TagsService) DeleteTag(ctx context.Context, id int) error {
 		return err
 	}
 
-	response := struct {
-		Deleted bool `json:"deleted"`
-		Id string `json:"id"`
-	}{}
-	_, err = s.client.Do(ctx, req, &response)
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// ListContactsByTag Lists all contacts having the given tag
+func (s *TagsService) ListContactsByTag(ctx context.Context, tagId int, opts *ContactListOptions) (*[]*Contact, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("tags/%d/contacts", tagId), opts)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
-}
\ No newline at end of file
+	response := new(listContactsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListAllTags walks all pages of tags starting at opts.Page and calls fn for
+// every tag. Iteration stops at the first error returned by fn.
+func (s *TagsService) ListAllTags(ctx context.Context, opts *TagListOptions, fn func(*Tag) error) error {
+	o := TagListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var tags *[]*Tag
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		tags, meta, err = s.ListTags(ctx, &o)
+		return meta, err
+	}, func() error {
+		if tags == nil {
+			return nil
+		}
+		for _, tag := range *tags {
+			if err := fn(tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
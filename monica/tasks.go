@@ -0,0 +1,214 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TasksService handles communication with the task related methods of the API.
+// API docs: https://www.monicahq.com/api/tasks
+type TasksService service
+
+type Task struct {
+	Id          int       `json:"id,omitempty"`
+	Object      string    `json:"object,omitempty"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Completed   bool      `json:"completed"`
+	CompletedAt Timestamp `json:"completed_at,omitempty"`
+	Account     struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	// Contact is nil for tasks not related to a contact
+	Contact *Contact `json:"contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+type TaskInput struct {
+	// Title of the task, max 255 characters
+	Title string `json:"title"`
+	// Description of the task, max 1000000 characters
+	Description string     `json:"description,omitempty"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *Timestamp `json:"completed_at,omitempty"`
+	// ContactId is optional, 0 creates a task not related to a contact
+	ContactId int `json:"contact_id,omitempty"`
+}
+
+type TaskListOptions struct {
+	ListOptions
+}
+
+type listTasksResponse struct {
+	Data *[]*Task `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// ListTasks Lists all tasks
+func (s *TasksService) ListTasks(ctx context.Context, opts *TaskListOptions) (*[]*Task, *ListMeta, error) {
+	url, err := addOptions("tasks", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listTasksResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// ListTasksForContact Lists the tasks of a single contact
+func (s *TasksService) ListTasksForContact(ctx context.Context, contactId int, opts *TaskListOptions) (*[]*Task, *ListMeta, error) {
+	url, err := addOptions(fmt.Sprintf("contacts/%d/tasks", contactId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listTasksResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetTask Retrieves a single task
+func (s *TasksService) GetTask(ctx context.Context, id int) (*Task, error) {
+	url := fmt.Sprintf("tasks/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Task `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// CreateTask Creates a task
+func (s *TasksService) CreateTask(ctx context.Context, input *TaskInput) (*Task, error) {
+	req, err := s.client.NewRequest("POST", "tasks", input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Task `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// UpdateTask Updates a task
+func (s *TasksService) UpdateTask(ctx context.Context, id int, input *TaskInput) (*Task, error) {
+	url := fmt.Sprintf("tasks/%d", id)
+	req, err := s.client.NewRequest("PUT", url, input)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Task `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// DeleteTask Deletes a task
+func (s *TasksService) DeleteTask(ctx context.Context, id int) error {
+	url := fmt.Sprintf("tasks/%d", id)
+	req, err := s.client.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Do(ctx, req, nil)
+	return err
+}
+
+// CompleteTask Marks a task as completed, or as not completed. As the API
+// only supports full updates, the task is fetched first. Completing an already
+// completed task keeps its completion time.
+func (s *TasksService) CompleteTask(ctx context.Context, id int, completed bool) (*Task, error) {
+	task, err := s.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, ErrEmptyResponse
+	}
+
+	input := TaskInput{
+		Title:       task.Title,
+		Description: task.Description,
+		Completed:   completed,
+	}
+	if task.Contact != nil {
+		input.ContactId = task.Contact.Id
+	}
+	if completed {
+		completedAt := Timestamp{time.Now()}
+		if task.Completed && !task.CompletedAt.IsZero() {
+			completedAt = task.CompletedAt
+		}
+		input.CompletedAt = &completedAt
+	}
+
+	return s.UpdateTask(ctx, id, &input)
+}
+
+// ListAllTasks walks all pages of tasks starting at opts.Page and calls fn for
+// every task. Iteration stops at the first error returned by fn.
+func (s *TasksService) ListAllTasks(ctx context.Context, opts *TaskListOptions, fn func(*Task) error) error {
+	o := TaskListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var tasks *[]*Task
+	return paginate(ctx, &o.ListOptions, func() (meta *ListMeta, err error) {
+		tasks, meta, err = s.ListTasks(ctx, &o)
+		return meta, err
+	}, func() error {
+		if tasks == nil {
+			return nil
+		}
+		for _, task := range *tasks {
+			if err := fn(task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -1,8 +1,8 @@
 package monica
 
 import (
-"strconv"
-"time"
+	"strconv"
+	"time"
 )
 
 // Timestamp represents a time that can be unmarshalled from a JSON string
@@ -17,9 +17,13 @@ func (t Timestamp) String() string {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339/ISO-8601 or Unix format.
+// Time is expected in RFC3339/ISO-8601 or Unix format, null is left as the
+// zero time.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
+	if str == "null" {
+		return nil
+	}
 	i, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
 		t.Time = time.Unix(i, 0)
@@ -37,3 +41,51 @@ func (t Timestamp) Equal(u Timestamp) bool {
 	return t.Time.Equal(u.Time)
 }
 
+// dateLayout is the format the API uses for plain dates
+const dateLayout = "2006-01-02"
+
+// Date represents a calendar date without a time of day, encoded as
+// "2006-01-02" in JSON. All exported methods of time.Time can be called on
+// Date.
+type Date struct {
+	time.Time
+}
+
+// NewDate returns the Date of given year, month and day in UTC
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func (d Date) String() string {
+	return d.Time.Format(dateLayout)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The zero Date is encoded as null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.Time.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.Time.Format(dateLayout) + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Dates are expected as "2006-01-02" or as RFC3339/ISO-8601 timestamp, null is
+// left as the zero date.
+func (d *Date) UnmarshalJSON(data []byte) (err error) {
+	str := string(data)
+	if str == "null" {
+		return nil
+	}
+
+	d.Time, err = time.Parse(`"`+dateLayout+`"`, str)
+	if err != nil {
+		d.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
+	}
+	return
+}
+
+// Equal reports whether d and u are the same date
+func (d Date) Equal(u Date) bool {
+	return d.Time.Equal(u.Time)
+}
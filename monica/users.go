@@ -0,0 +1,198 @@
+package monica
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// UserService handles communication with the methods of the API related to
+// the authenticated user.
+// API docs: https://www.monicahq.com/api/users
+type UserService service
+
+// ComplianceService handles communication with the terms and privacy policy
+// related methods of the API.
+// API docs: https://www.monicahq.com/api/compliance
+type ComplianceService service
+
+// User is the user the access token belongs to
+type User struct {
+	Id                int    `json:"id,omitempty"`
+	Object            string `json:"object,omitempty"`
+	FirstName         string `json:"first_name"`
+	LastName          string `json:"last_name"`
+	Email             string `json:"email"`
+	Locale            string `json:"locale"`
+	IsPolicyCompliant bool   `json:"is_policy_compliant"`
+	Account           struct {
+		Id int `json:"id,omitempty"`
+	} `json:"account,omitempty"`
+	// Timezone is an IANA time zone name, like "Europe/Berlin"
+	Timezone string    `json:"timezone"`
+	Currency *Currency `json:"currency"`
+	// MeContact is the contact representing the user, nil if none is set
+	MeContact *Contact `json:"me_contact"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+}
+
+// Term is a version of the terms of use and privacy policy
+type Term struct {
+	Id             int    `json:"id,omitempty"`
+	Object         string `json:"object,omitempty"`
+	TermVersion    string `json:"term_version"`
+	TermContent    string `json:"term_content"`
+	PrivacyVersion string `json:"privacy_version"`
+	PrivacyContent string `json:"privacy_content"`
+
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+}
+
+// TermStatus tells whether the user signed a Term
+type TermStatus struct {
+	Signed    bool      `json:"signed"`
+	SignedAt  Timestamp `json:"signed_at"`
+	IpAddress string    `json:"ip_address"`
+	User      *User     `json:"user"`
+	Term      *Term     `json:"term"`
+}
+
+type signTermsInput struct {
+	IpAddress string `json:"ip_address"`
+}
+
+type TermListOptions struct {
+	ListOptions
+}
+
+type listTermsResponse struct {
+	Data *[]*Term `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// Location returns the time zone of the user, or UTC if none is set
+func (u *User) Location() (*time.Location, error) {
+	if u.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(u.Timezone)
+}
+
+// GetMe Retrieves the user the access token belongs to
+func (s *UserService) GetMe(ctx context.Context) (*User, error) {
+	req, err := s.client.NewRequest("GET", "me", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *User `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListComplianceStatuses Lists whether the user signed each version of the
+// terms
+func (s *UserService) ListComplianceStatuses(ctx context.Context) (*[]*TermStatus, error) {
+	req, err := s.client.NewRequest("GET", "me/compliance", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *[]*TermStatus `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetComplianceStatus Retrieves whether the user signed a single version of
+// the terms
+func (s *UserService) GetComplianceStatus(ctx context.Context, termId int) (*TermStatus, error) {
+	url := fmt.Sprintf("me/compliance/%d", termId)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *TermStatus `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// SignTerms Signs the latest version of the terms on behalf of the user.
+// ipAddress is the address of the user signing them.
+func (s *UserService) SignTerms(ctx context.Context, ipAddress string) (*TermStatus, error) {
+	body := signTermsInput{IpAddress: ipAddress}
+	req, err := s.client.NewRequest("POST", "me/compliance", body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *TermStatus `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// ListTerms Lists all versions of the terms
+func (s *ComplianceService) ListTerms(ctx context.Context, opts *TermListOptions) (*[]*Term, *ListMeta, error) {
+	url, err := addOptions("compliance", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(listTermsResponse)
+	_, err = s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response.Data, &response.Meta, nil
+}
+
+// GetTerm Retrieves a single version of the terms
+func (s *ComplianceService) GetTerm(ctx context.Context, id int) (*Term, error) {
+	url := fmt.Sprintf("compliance/%d", id)
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Data *Term `json:"data"`
+	}{}
+	_, err = s.client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -20,3 +20,8 @@ func ContactToContactInput(contact Contact) ContactInput {
 		IsDeceasedDateKnown:    contact.IsDeceasedDateKnown,
 	}
 }
+
+// Bool returns a pointer to v, for optional boolean input fields
+func Bool(v bool) *bool {
+	return &v
+}